@@ -24,12 +24,7 @@ func (m *Backend) GetAccountBalances(accountAddressStr string, denom *string) (b
 			return nil, err
 		}
 
-		res := make(berpctypes.GenericBackendResponse)
-		for _, coin := range resAllBalances.Balances {
-			res[coin.Denom] = coin.Amount.String()
-		}
-
-		return res, nil
+		return m.balancesToResponse(resAllBalances.Balances), nil
 	}
 
 	resBalance, err := m.queryClient.BankQueryClient.Balance(m.ctx, &banktypes.QueryBalanceRequest{
@@ -40,9 +35,21 @@ func (m *Backend) GetAccountBalances(accountAddressStr string, denom *string) (b
 		return nil, err
 	}
 
-	res := make(berpctypes.GenericBackendResponse)
-	res[resBalance.Balance.Denom] = resBalance.Balance.Amount.String()
-	return res, nil
+	return m.balancesToResponse(sdk.Coins{*resBalance.Balance}), nil
+}
+
+// balancesToResponse returns the amount of each denom under "balances",
+// and the denom traces of IBC denoms under "denomTraces", so the balances contain nothing but denoms.
+func (m *Backend) balancesToResponse(balances sdk.Coins) berpctypes.GenericBackendResponse {
+	balancesInfo := make(map[string]string)
+	for _, coin := range balances {
+		balancesInfo[coin.Denom] = coin.Amount.String()
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"balances":    balancesInfo,
+		"denomTraces": m.getIbcDenomTraces(balances),
+	}
 }
 
 func (m *Backend) GetAccount(accountAddressStr string) (berpctypes.GenericBackendResponse, error) {
@@ -90,7 +97,8 @@ func (m *Backend) GetAccount(accountAddressStr string) (berpctypes.GenericBacken
 		return nil, err
 	}
 
-	res["balances"] = balancesInfo
+	res["balances"] = balancesInfo["balances"]
+	res["denomTraces"] = balancesInfo["denomTraces"]
 
 	// get account transaction count
 
@@ -145,24 +153,9 @@ func (m *Backend) GetValidatorAccount(consOrValAddr string) (berpctypes.GenericB
 		res = res.ReInitializeIfNil()
 	}
 
-	stakingValidators, err := m.stakingValidatorsCache.GetValidators()
+	validator, err := m.findStakingValidator(consOrValAddr)
 	if err != nil {
-		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking validators").Error())
-	}
-	if len(stakingValidators) < 1 {
-		return nil, status.Error(codes.NotFound, "validator could not be found")
-	}
-
-	var validator cachedValidator
-
-	for _, stakingValidator := range stakingValidators {
-		if stakingValidator.consAddr == consOrValAddr || stakingValidator.validator.OperatorAddress == consOrValAddr {
-			validator = stakingValidator
-			break
-		}
-	}
-	if validator.consAddr == "" {
-		return nil, status.Error(codes.NotFound, "validator could not be found")
+		return nil, err
 	}
 
 	res["address"] = berpctypes.GenericBackendResponse{
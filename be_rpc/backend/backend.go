@@ -37,7 +37,19 @@ type BackendI interface {
 	GetRecentBlocks(pageNo, pageSize int) (berpctypes.GenericBackendResponse, error)
 
 	// GetBlockByNumber returns a block by its height.
-	GetBlockByNumber(height int64) (berpctypes.GenericBackendResponse, error)
+	// When detailed is true, the full block header and the last commit are included.
+	GetBlockByNumber(height int64, detailed bool) (berpctypes.GenericBackendResponse, error)
+
+	// GetBlockByHash returns a block by its hash.
+	GetBlockByHash(hash string) (berpctypes.GenericBackendResponse, error)
+
+	// GetBlockByTime returns the block nearest to the given epoch UTC seconds, depends on direction:
+	//   - "after" (default): the first block at or after the given time.
+	//   - "before": the last block before the given time.
+	GetBlockByTime(epochUTC int64, direction string) (berpctypes.GenericBackendResponse, error)
+
+	// GetBlockEvents returns the begin block events, end block events and validator updates of a block.
+	GetBlockEvents(height int64) (berpctypes.GenericBackendResponse, error)
 
 	// Transactions
 
@@ -48,6 +60,34 @@ type BackendI interface {
 	// GetTransactionByHash returns a transaction by its hash.
 	GetTransactionByHash(hash string) (berpctypes.GenericBackendResponse, error)
 
+	// GetPendingTransactions returns the unconfirmed transactions in the mempool of the node, up to the given limit,
+	// with decoded message types, signers, fee and gas limit. Limit 0 means using the default limit (30).
+	GetPendingTransactions(limit int) (berpctypes.GenericBackendResponse, error)
+
+	// GetPendingTransaction returns the transaction with pending state if it is still in the mempool of the node,
+	// or included state with the block height if it was already included in a block.
+	// Unknown state is returned if not found while the mempool is larger than the part can be scanned.
+	GetPendingTransaction(hash string) (berpctypes.GenericBackendResponse, error)
+
+	// DecodeTransaction decodes the raw tx bytes, encoded in base64 or hex, returns the parsed messages,
+	// involvers, fee and memo, same structure as GetTransactionByHash but without execution results.
+	DecodeTransaction(txBytes string) (berpctypes.GenericBackendResponse, error)
+
+	// SimulateTransaction simulates the raw tx bytes, encoded in base64 or hex, returns the gas used,
+	// recommended gas limit after applying the gas adjustment, estimated fee at the node's minimum gas prices,
+	// the resulting events and parsed messages.
+	// Gas adjustment 0 means using the configured value.
+	SimulateTransaction(txBytes string, gasAdjustment float64) (berpctypes.GenericBackendResponse, error)
+
+	// BroadcastTransaction broadcasts the raw tx bytes, encoded in base64 or hex, returns the check-tx result.
+	// Mode can be:
+	//   - "sync" (default): returns after the tx passed check-tx.
+	//   - "async": returns immediately without waiting for check-tx.
+	//   - "wait": same as "sync" but also waits until the tx was included in a block or timed out,
+	//     then returns the inclusion height and the tx in the same format of GetTransactionByHash.
+	//     Once the tx passed check-tx, failures of waiting or fetching the tx are reported as part of the response.
+	BroadcastTransaction(txBytes string, mode string) (berpctypes.GenericBackendResponse, error)
+
 	// Staking
 
 	// GetStakingInfo returns the staking information, includes:
@@ -55,13 +95,92 @@ type BackendI interface {
 	// - Validator's commission & outstanding rewards
 	GetStakingInfo(delegatorAddr string) (berpctypes.GenericBackendResponse, error)
 
-	GetValidators() (berpctypes.GenericBackendResponse, error)
+	// GetDelegatorStakingDetails returns the detailed staking information of a delegator, includes:
+	// - Delegations with rewards per validator
+	// - Pending unbonding entries and in-flight re-delegations, with completion time
+	// - Withdraw address
+	GetDelegatorStakingDetails(delegatorAddr string) (berpctypes.GenericBackendResponse, error)
+
+	// GetValidators returns the validators, filtered by status and sorted by the given option.
+	//   - Status filter: bonded (default), unbonding, unbonded, jailed, all.
+	//   - Sort by: tokens (default), commission, moniker, uptime.
+	// Validators are returned as an array, ordered by the given sort option.
+	GetValidators(statusFilter, sortBy string) (berpctypes.GenericBackendResponse, error)
+
+	// GetValidatorUptime returns the signing information of a validator,
+	// along with the signed/missed bitmap of the recent blocks within the given window.
+	// Window 0 means using the default window (100 blocks).
+	GetValidatorUptime(consOrValAddr string, window int64) (berpctypes.GenericBackendResponse, error)
+
+	// GetValidatorDelegations returns the delegations to a validator, sorted by amount descending, paginated.
+	GetValidatorDelegations(valAddr string, pageNo int) (berpctypes.GenericBackendResponse, error)
+
+	// GetValidatorUnbondingDelegations returns the unbonding delegations from a validator,
+	// sorted by amount descending, paginated.
+	GetValidatorUnbondingDelegations(valAddr string, pageNo int) (berpctypes.GenericBackendResponse, error)
+
+	// GetValidatorRedelegations returns the re-delegations from a validator, sorted by amount descending, paginated.
+	GetValidatorRedelegations(valAddr string, pageNo int) (berpctypes.GenericBackendResponse, error)
+
+	// GetStakingEconomics returns the network staking economics: inflation, annual provisions, bonded ratio,
+	// community tax, estimated APR of the network and of each bonded validator after commission.
+	GetStakingEconomics() (berpctypes.GenericBackendResponse, error)
+
+	// GetVotingPowerDistribution returns the voting power distribution of the validator set,
+	// includes cumulative share, Nakamoto coefficient, Gini coefficient and top-N concentration.
+	// Height 0 means the latest validator set, top N 0 means using the default (10).
+	GetVotingPowerDistribution(height int64, topN int) (berpctypes.GenericBackendResponse, error)
 
 	// Gov
 
+	// GetGovProposal returns the proposal, with live tally and projected outcome if it is in voting period.
 	GetGovProposal(proposalId uint64) (berpctypes.GenericBackendResponse, error)
 
-	GetGovProposals(pageNo int) (berpctypes.GenericBackendResponse, error)
+	// GetGovProposals returns the proposals, paginated with the given page size,
+	// optionally filtered by status, proposer, depositor or voter.
+	// Page size 0 means using the default page size (20).
+	GetGovProposals(pageNo, pageSize int, filter berpctypes.GovProposalsFilter) (berpctypes.GenericBackendResponse, error)
+
+	// GetGovProposalVotes returns the votes of a proposal, paginated, optionally filtered by vote option.
+	// Votes cast by validator operators are annotated with the validator moniker.
+	GetGovProposalVotes(proposalId uint64, pageNo int, option string) (berpctypes.GenericBackendResponse, error)
+
+	// GetGovProposalDeposits returns the deposits of a proposal, paginated.
+	GetGovProposalDeposits(proposalId uint64, pageNo int) (berpctypes.GenericBackendResponse, error)
+
+	// GetValidatorGovParticipation returns, for every proposal that reached voting period,
+	// whether the validator operator account voted (including votes executed via authz) and the weighted options,
+	// with the overall participation rate.
+	GetValidatorGovParticipation(valAddr string) (berpctypes.GenericBackendResponse, error)
+
+	// IBC
+
+	// GetIbcChannels returns the IBC channels, paginated, with counterparty and client information.
+	GetIbcChannels(pageNo int) (berpctypes.GenericBackendResponse, error)
+
+	// GetIbcChannel returns the IBC channel with counterparty, connection and client information.
+	GetIbcChannel(port, channel string) (berpctypes.GenericBackendResponse, error)
+
+	// GetIbcConnections returns the IBC connections, paginated, with counterparty and client information.
+	GetIbcConnections(pageNo int) (berpctypes.GenericBackendResponse, error)
+
+	// GetIbcClients returns the IBC clients, paginated, with status, counterparty chain id, latest height
+	// and trusting period.
+	GetIbcClients(pageNo int) (berpctypes.GenericBackendResponse, error)
+
+	// GetIbcPacket returns the lifecycle of an IBC packet: the send/receive, acknowledgement or timeout transactions
+	// found in the tx index, packet commitment/receipt/acknowledgement existence and decoded ICS-20 packet data.
+	// Destination port and channel are optional, required to distinguish incoming packets
+	// when multiple channels of this chain are connected to the same source port and channel.
+	// Direction is optional, either "incoming" or "outgoing", required when both an outgoing and an incoming packet
+	// match the given source port, channel and sequence.
+	GetIbcPacket(srcPort, srcChannel string, sequence uint64, dstPort, dstChannel, direction string) (berpctypes.GenericBackendResponse, error)
+
+	// GetIbcChannelBacklog returns the pending packets of a channel (count and the oldest sequences),
+	// the oldest pending packet age and the recent relayers seen relaying packets and acknowledgements of the channel.
+	// Acknowledgements not yet relayed back to the counterparty are not returned,
+	// they can not be determined using the state of this chain alone.
+	GetIbcChannelBacklog(port, channel string) (berpctypes.GenericBackendResponse, error)
 
 	// Misc
 
@@ -98,6 +217,8 @@ type Backend struct {
 	bech32Cfg                 berpctypes.Bech32Config
 	tendermintValidatorsCache *tendermintValidatorsCache
 	stakingValidatorsCache    *stakingValidatorsCache
+	ibcDenomTracesCache       *ibcDenomTracesCache
+	validatorRecordsCache     *validatorRecordsCache
 }
 
 // NewBackend creates a new Backend instance for RollApp Block Explorer
@@ -135,6 +256,10 @@ func NewBackend(
 			queryClient.StakingQueryClient,
 			clientCtx.Codec,
 		),
+		ibcDenomTracesCache: NewIbcDenomTracesCache(
+			queryClient.IbcTransferQueryClient,
+		),
+		validatorRecordsCache: NewValidatorRecordsCache(),
 	}
 }
 
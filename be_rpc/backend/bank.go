@@ -58,12 +58,15 @@ func (m *Backend) GetTotalSupply(pageNo int) (berpctypes.GenericBackendResponse,
 		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get total supply").Error())
 	}
 
-	res := make(berpctypes.GenericBackendResponse)
+	supply := make(map[string]string)
 	for _, coin := range resTotalSupply.Supply {
-		res[coin.Denom] = coin.Amount.String()
+		supply[coin.Denom] = coin.Amount.String()
 	}
 
-	return res, nil
+	return berpctypes.GenericBackendResponse{
+		"supply":      supply,
+		"denomTraces": m.getIbcDenomTraces(resTotalSupply.Supply),
+	}, nil
 }
 
 func (m *Backend) GetBankDenomsMetadata(coins sdk.Coins) map[string]banktypes.Metadata {
@@ -78,42 +81,108 @@ func (m *Backend) GetBankDenomsMetadata(coins sdk.Coins) map[string]banktypes.Me
 		denomsMetadata[coin.Denom] = res.Metadata
 	}
 
-	if len(denomsMetadata) == 0 && len(coins) > 0 {
+	// decided before resolving IBC denoms, so metadata resolved for IBC denoms does not affect the native coins
+	usePseudoDenomMetadata := len(denomsMetadata) == 0 && len(coins) > 0
+
+	// IBC denoms are rendered using metadata of the base denom
+	for _, coin := range coins {
+		if _, found := denomsMetadata[coin.Denom]; found || !isIbcDenom(coin.Denom) {
+			continue
+		}
+
+		denomTrace, err := m.ibcDenomTracesCache.GetDenomTrace(coin.Denom)
+		if err != nil {
+			m.GetLogger().Error("failed to get denom trace", "denom", coin.Denom, "error", err)
+			continue
+		}
+
+		res, err := m.queryClient.BankQueryClient.DenomMetadata(m.ctx, &banktypes.QueryDenomMetadataRequest{
+			Denom: denomTrace.BaseDenom,
+		})
+		if err == nil && res != nil {
+			denomsMetadata[coin.Denom] = res.Metadata
+			continue
+		}
+
+		if metadata, ok := getPseudoDenomMetadata(denomTrace.BaseDenom); ok {
+			denomsMetadata[coin.Denom] = metadata
+		}
+	}
+
+	if usePseudoDenomMetadata {
 		// trying to insert denom metadata for the default RollApp coin
 
 		for _, coin := range coins {
-			if len(coin.Denom) < 2 {
+			if _, found := denomsMetadata[coin.Denom]; found {
 				continue
 			}
-
-			prefixA := strings.HasPrefix(coin.Denom, "a")
-			prefixU := strings.HasPrefix(coin.Denom, "u")
-			if !prefixA && !prefixU {
-				continue
-			}
-
-			// add pseudo data based on naming convention
-			display := strings.ToUpper(coin.Denom[1:])
-			denomsMetadata[coin.Denom] = banktypes.Metadata{
-				DenomUnits: []*banktypes.DenomUnit{{
-					Denom:    coin.Denom,
-					Exponent: 0,
-				}, {
-					Denom: display,
-					Exponent: func() uint32 {
-						if prefixA {
-							return 18
-						}
-						return 6
-					}(),
-				}},
-				Base:    coin.Denom,
-				Display: display,
-				Name:    display,
-				Symbol:  display,
+			if metadata, ok := getPseudoDenomMetadata(coin.Denom); ok {
+				denomsMetadata[coin.Denom] = metadata
 			}
 		}
 	}
 
 	return denomsMetadata
 }
+
+// getPseudoDenomMetadata builds pseudo denom metadata based on naming convention:
+// prefix 'a' means 18 decimals and prefix 'u' means 6 decimals.
+func getPseudoDenomMetadata(denom string) (banktypes.Metadata, bool) {
+	if len(denom) < 2 {
+		return banktypes.Metadata{}, false
+	}
+
+	prefixA := strings.HasPrefix(denom, "a")
+	prefixU := strings.HasPrefix(denom, "u")
+	if !prefixA && !prefixU {
+		return banktypes.Metadata{}, false
+	}
+
+	// add pseudo data based on naming convention
+	display := strings.ToUpper(denom[1:])
+	return banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    denom,
+			Exponent: 0,
+		}, {
+			Denom: display,
+			Exponent: func() uint32 {
+				if prefixA {
+					return 18
+				}
+				return 6
+			}(),
+		}},
+		Base:    denom,
+		Display: display,
+		Name:    display,
+		Symbol:  display,
+	}, true
+}
+
+func isIbcDenom(denom string) bool {
+	return strings.HasPrefix(denom, "ibc/")
+}
+
+// getIbcDenomTraces returns the denom traces (path and base denom) of IBC denoms of the given coins.
+// Any error is logged and the denom will be omitted.
+func (m *Backend) getIbcDenomTraces(coins sdk.Coins) map[string]any {
+	denomTraces := make(map[string]any)
+	for _, coin := range coins {
+		if !isIbcDenom(coin.Denom) {
+			continue
+		}
+
+		denomTrace, err := m.ibcDenomTracesCache.GetDenomTrace(coin.Denom)
+		if err != nil {
+			m.GetLogger().Error("failed to get denom trace", "denom", coin.Denom, "error", err)
+			continue
+		}
+
+		denomTraces[coin.Denom] = map[string]string{
+			"path":      denomTrace.Path,
+			"baseDenom": denomTrace.BaseDenom,
+		}
+	}
+	return denomTraces
+}
@@ -8,8 +8,18 @@ import (
 	berpcutils "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
 	"github.com/tendermint/tendermint/libs/math"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -74,7 +84,7 @@ func (m *Backend) GetRecentBlocks(pageNo, pageSize int) (berpctypes.GenericBacke
 	}, nil
 }
 
-func (m *Backend) GetBlockByNumber(height int64) (berpctypes.GenericBackendResponse, error) {
+func (m *Backend) GetBlockByNumber(height int64, detailed bool) (berpctypes.GenericBackendResponse, error) {
 	resBlock, err := m.queryClient.ServiceClient.GetBlockWithTxs(m.ctx, &tx.GetBlockWithTxsRequest{
 		Height: height,
 	})
@@ -88,6 +98,10 @@ func (m *Backend) GetBlockByNumber(height int64) (berpctypes.GenericBackendRespo
 	response := m.getBasicBlockInformation(resBlock)
 	delete(response, "txsCount") // maintains legacy format
 
+	if detailed {
+		m.addDetailedBlockInformation(resBlock, response)
+	}
+
 	sdkCtx := sdk.NewContext(nil, resBlock.Block.Header, false, nil).
 		WithBlockHeight(resBlock.Block.Header.Height).
 		WithBlockTime(resBlock.Block.Header.Time)
@@ -169,10 +183,14 @@ func (m *Backend) GetBlockByNumber(height int64) (berpctypes.GenericBackendRespo
 
 		if tx.AuthInfo != nil {
 			if tx.AuthInfo.Fee != nil {
-				txInfo["fee"] = map[string]any{
+				feeInfo := map[string]any{
 					"gasLimit": tx.AuthInfo.Fee.GasLimit,
 					"amount":   berpcutils.CoinsToMap(tx.AuthInfo.Fee.Amount...),
 				}
+				if denomTraces := m.getIbcDenomTraces(tx.AuthInfo.Fee.Amount); len(denomTraces) > 0 {
+					feeInfo["denomTraces"] = denomTraces
+				}
+				txInfo["fee"] = feeInfo
 			}
 			if tx.AuthInfo.Tip != nil {
 				txInfo["tip"] = map[string]any{
@@ -204,6 +222,10 @@ func (m *Backend) GetBlockByNumber(height int64) (berpctypes.GenericBackendRespo
 
 		txInfo["type"] = txType
 
+		if failure := getTxFailureInfo(txResult); failure != nil {
+			txInfo["failure"] = failure
+		}
+
 		txsInfo = append(txsInfo, txInfo)
 	}
 
@@ -212,6 +234,105 @@ func (m *Backend) GetBlockByNumber(height int64) (berpctypes.GenericBackendRespo
 	return response, nil
 }
 
+func (m *Backend) GetBlockByHash(hash string) (berpctypes.GenericBackendResponse, error) {
+	hash = strings.TrimSpace(hash)
+	if !patternTxHash.MatchString(hash) {
+		return nil, berpctypes.ErrBadRequest
+	}
+
+	hashBz, err := hex.DecodeString(berpcutils.NormalizeTransactionHash(hash, false)[2:])
+	if err != nil {
+		return nil, berpctypes.ErrBadRequest
+	}
+
+	resBlock, err := m.clientCtx.Client.BlockByHash(m.ctx, hashBz)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("block not found %s", hash))
+	}
+
+	return m.GetBlockByNumber(resBlock.Block.Height, false)
+}
+
+const (
+	blockTimeDirectionAfter  = "after"
+	blockTimeDirectionBefore = "before"
+)
+
+func (m *Backend) GetBlockByTime(epochUTC int64, direction string) (berpctypes.GenericBackendResponse, error) {
+	direction = strings.ToLower(strings.TrimSpace(direction))
+	if direction == "" {
+		direction = blockTimeDirectionAfter
+	}
+	if direction != blockTimeDirectionAfter && direction != blockTimeDirectionBefore {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("direction must be either %s or %s", blockTimeDirectionAfter, blockTimeDirectionBefore))
+	}
+	if epochUTC < 0 {
+		return nil, berpctypes.ErrBadRequest
+	}
+
+	statusInfo, err := m.clientCtx.Client.Status(m.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	earliestBlockNumber := math.MaxInt64(1, statusInfo.SyncInfo.EarliestBlockHeight)
+	latestBlockNumber := statusInfo.SyncInfo.LatestBlockHeight
+
+	// isAtOrAfter reports whether the block at the given height was produced at or after the requested time.
+	isAtOrAfter := func(height int64) (bool, error) {
+		blockTimeEpochUTC, err := m.getBlockTimeEpochUTC(height)
+		if err != nil {
+			return false, err
+		}
+		return blockTimeEpochUTC >= epochUTC, nil
+	}
+
+	// binary search for the first block produced at or after the requested time
+	low, high := earliestBlockNumber, latestBlockNumber+1
+	for low < high {
+		mid := low + (high-low)/2
+		atOrAfter, err := isAtOrAfter(mid)
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, fmt.Sprintf("failed to get block time %d", mid)).Error())
+		}
+		if atOrAfter {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	var height int64
+	if direction == blockTimeDirectionAfter {
+		height = low
+	} else {
+		height = low - 1
+	}
+
+	if height < earliestBlockNumber || height > latestBlockNumber {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no block %s %d", direction, epochUTC))
+	}
+
+	return m.GetBlockByNumber(height, false)
+}
+
+// getBlockTimeEpochUTC returns the block time of the given height, in epoch UTC seconds.
+// Only the block header is fetched, to keep it cheap for repeated lookups.
+func (m *Backend) getBlockTimeEpochUTC(height int64) (int64, error) {
+	resBlockchainInfo, err := m.clientCtx.Client.BlockchainInfo(m.ctx, height, height)
+	if err != nil {
+		return 0, err
+	}
+	if resBlockchainInfo == nil || len(resBlockchainInfo.BlockMetas) < 1 || resBlockchainInfo.BlockMetas[0] == nil {
+		return 0, fmt.Errorf("block not found %d", height)
+	}
+
+	return resBlockchainInfo.BlockMetas[0].Header.Time.UTC().Unix(), nil
+}
+
 func (m *Backend) getBasicBlockInformation(resBlock *tx.GetBlockWithTxsResponse) berpctypes.GenericBackendResponse {
 	block := resBlock.Block
 	result := berpctypes.GenericBackendResponse{
@@ -239,3 +360,292 @@ func (m *Backend) getBasicBlockInformation(resBlock *tx.GetBlockWithTxsResponse)
 
 	return result
 }
+
+// addDetailedBlockInformation adds the full block header and the last commit into the response.
+func (m *Backend) addDetailedBlockInformation(resBlock *tx.GetBlockWithTxsResponse, response berpctypes.GenericBackendResponse) {
+	header := resBlock.Block.Header
+
+	toHex := func(bz []byte) string {
+		return strings.ToUpper(hex.EncodeToString(bz))
+	}
+
+	response["header"] = berpctypes.GenericBackendResponse{
+		"chainId": header.ChainID,
+		"version": berpctypes.GenericBackendResponse{
+			"app":   header.Version.App,
+			"block": header.Version.Block,
+		},
+		"lastBlockId": berpctypes.GenericBackendResponse{
+			"hash": toHex(header.LastBlockId.Hash),
+			"partSetHeader": berpctypes.GenericBackendResponse{
+				"total": header.LastBlockId.PartSetHeader.Total,
+				"hash":  toHex(header.LastBlockId.PartSetHeader.Hash),
+			},
+		},
+		"lastCommitHash":     toHex(header.LastCommitHash),
+		"dataHash":           toHex(header.DataHash),
+		"validatorsHash":     toHex(header.ValidatorsHash),
+		"nextValidatorsHash": toHex(header.NextValidatorsHash),
+		"consensusHash":      toHex(header.ConsensusHash),
+		"appHash":            toHex(header.AppHash),
+		"lastResultsHash":    toHex(header.LastResultsHash),
+		"evidenceHash":       toHex(header.EvidenceHash),
+	}
+
+	lastCommit := resBlock.Block.LastCommit
+	if lastCommit == nil {
+		return
+	}
+
+	monikerByConsAddr := m.getValidatorMonikersByConsensusAddress()
+
+	var committedCount, absentCount, nilCount int
+	signatures := make([]berpctypes.GenericBackendResponse, 0, len(lastCommit.Signatures))
+	for _, commitSig := range lastCommit.Signatures {
+		var flag string
+		switch commitSig.BlockIdFlag {
+		case tmproto.BlockIDFlagCommit:
+			flag = "committed"
+			committedCount++
+		case tmproto.BlockIDFlagAbsent:
+			flag = "absent"
+			absentCount++
+		case tmproto.BlockIDFlagNil:
+			flag = "nil"
+			nilCount++
+		default:
+			flag = "unknown"
+		}
+
+		signature := berpctypes.GenericBackendResponse{
+			"flag": flag,
+		}
+
+		if len(commitSig.ValidatorAddress) > 0 {
+			consAddr := sdk.ConsAddress(commitSig.ValidatorAddress).String()
+			signature["consensusAddress"] = consAddr
+			signature["moniker"] = monikerByConsAddr[consAddr]
+		}
+
+		if commitSig.BlockIdFlag != tmproto.BlockIDFlagAbsent {
+			signature["timeEpochUTC"] = commitSig.Timestamp.UTC().Unix()
+		}
+
+		signatures = append(signatures, signature)
+	}
+
+	response["lastCommit"] = berpctypes.GenericBackendResponse{
+		"height":         lastCommit.Height,
+		"round":          lastCommit.Round,
+		"blockHash":      toHex(lastCommit.BlockID.Hash),
+		"committedCount": committedCount,
+		"absentCount":    absentCount,
+		"nilCount":       nilCount,
+		"signatures":     signatures,
+	}
+}
+
+func (m *Backend) GetBlockEvents(height int64) (berpctypes.GenericBackendResponse, error) {
+	if height < 1 {
+		return nil, berpctypes.ErrBadRequest
+	}
+
+	resBlockResults, err := m.clientCtx.Client.BlockResults(m.ctx, &height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if resBlockResults == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("block results not found %d", height))
+	}
+
+	monikerByConsAddr := m.getValidatorMonikersByConsensusAddress()
+
+	validatorUpdates := make([]berpctypes.GenericBackendResponse, 0)
+	for _, validatorUpdate := range resBlockResults.ValidatorUpdates {
+		validatorUpdateInfo := berpctypes.GenericBackendResponse{
+			"power": validatorUpdate.Power,
+		}
+
+		pubKey, err := cryptoenc.PubKeyFromProto(validatorUpdate.PubKey)
+		if err == nil {
+			consAddr := sdk.ConsAddress(pubKey.Address()).String()
+			validatorUpdateInfo["consensusAddress"] = consAddr
+			validatorUpdateInfo["pubKeyType"] = pubKey.Type()
+			validatorUpdateInfo["moniker"] = monikerByConsAddr[consAddr]
+		} else {
+			validatorUpdateInfo["pubKeyError"] = err.Error()
+		}
+
+		validatorUpdates = append(validatorUpdates, validatorUpdateInfo)
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"height":           resBlockResults.Height,
+		"beginBlockEvents": m.convertBlockEvents(resBlockResults.BeginBlockEvents),
+		"endBlockEvents":   m.convertBlockEvents(resBlockResults.EndBlockEvents),
+		"validatorUpdates": validatorUpdates,
+	}, nil
+}
+
+// convertBlockEvents converts the begin/end block events into response format,
+// with friendly content for the known event types.
+func (m *Backend) convertBlockEvents(events []abci.Event) []berpctypes.GenericBackendResponse {
+	res := make([]berpctypes.GenericBackendResponse, 0, len(events))
+
+	// mint denom is resolved once, only when needed
+	var mintDenom string
+	var mintDenomResolved, mintDenomQueried bool
+	resolveMintDenom := func() (string, bool) {
+		if !mintDenomQueried {
+			mintDenomQueried = true
+			denom, err := m.getMintDenom()
+			if err != nil {
+				m.GetLogger().Error("failed to get mint denom", "error", err)
+			} else {
+				mintDenom = denom
+				mintDenomResolved = true
+			}
+		}
+		return mintDenom, mintDenomResolved
+	}
+
+	for _, event := range berpctypes.ConvertTxEvent(events) {
+		eventInfo := berpctypes.GenericBackendResponse{
+			"type":       event.Type,
+			"attributes": event.Attributes,
+		}
+
+		if rb := m.buildFriendlyBlockEventContent(event, resolveMintDenom); rb != nil {
+			rb.BuildIntoResponse(eventInfo)
+		}
+
+		res = append(res, eventInfo)
+	}
+
+	return res
+}
+
+// buildFriendlyBlockEventContent returns the friendly content builder for the known block event types,
+// returns nil if the event type is not supported.
+// The mint denom resolver returns false if the mint denom could not be resolved.
+func (m *Backend) buildFriendlyBlockEventContent(event berpctypes.TxEvent, resolveMintDenom func() (string, bool)) berpctypes.FriendlyResponseContentBuilderI {
+	attrs := make(map[string]string)
+	for _, attribute := range event.Attributes {
+		attrs[attribute.Key] = attribute.Value
+	}
+
+	amount := berpcutils.ParseEventAmount(attrs[sdk.AttributeKeyAmount], "")
+
+	switch event.Type {
+	case minttypes.EventTypeMint:
+		// mint event emits the minted amount as a bare integer, without denom
+		rb := berpctypes.NewFriendlyResponseContentBuilder().
+			WriteText("Minted ")
+		if mintDenom, resolved := resolveMintDenom(); resolved {
+			amount = berpcutils.ParseEventAmount(attrs[sdk.AttributeKeyAmount], mintDenom)
+			rb.WriteCoins(amount, m.GetBankDenomsMetadata(amount))
+		} else {
+			// denom is left out explicitly rather than rendering a denom-less amount as coins
+			rb.WriteText(attrs[sdk.AttributeKeyAmount]).WriteText(" (unknown denom)")
+		}
+		return rb.
+			WriteText(", inflation ").
+			WriteText(attrs[minttypes.AttributeKeyInflation]).
+			WriteText(", bonded ratio ").
+			WriteText(attrs[minttypes.AttributeKeyBondedRatio])
+	case disttypes.EventTypeProposerReward:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteText("Proposer ").
+			WriteAddress(attrs[disttypes.AttributeKeyValidator]).
+			WriteText(" receives proposer reward ").
+			WriteCoins(amount, m.GetBankDenomsMetadata(amount))
+	case disttypes.EventTypeCommission:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteText("Validator ").
+			WriteAddress(attrs[disttypes.AttributeKeyValidator]).
+			WriteText(" receives commission ").
+			WriteCoins(amount, m.GetBankDenomsMetadata(amount))
+	case disttypes.EventTypeRewards:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteText("Validator ").
+			WriteAddress(attrs[disttypes.AttributeKeyValidator]).
+			WriteText(" receives rewards ").
+			WriteCoins(amount, m.GetBankDenomsMetadata(amount))
+	case slashingtypes.EventTypeSlash:
+		rb := berpctypes.NewFriendlyResponseContentBuilder().
+			WriteText("Validator ").
+			WriteAddress(attrs[slashingtypes.AttributeKeyAddress]).
+			WriteText(" is slashed")
+		if reason := attrs[slashingtypes.AttributeKeyReason]; reason != "" {
+			rb.WriteText(" due to ").WriteText(reason)
+		}
+		if jailed := attrs[slashingtypes.AttributeKeyJailed]; jailed != "" {
+			rb.WriteText(" and jailed")
+		}
+		return rb
+	case slashingtypes.EventTypeLiveness:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteText("Validator ").
+			WriteAddress(attrs[slashingtypes.AttributeKeyAddress]).
+			WriteText(" missed ").
+			WriteText(attrs[slashingtypes.AttributeKeyMissedBlocks]).
+			WriteText(" blocks")
+	case stakingtypes.EventTypeCompleteUnbonding:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteAddress(attrs[stakingtypes.AttributeKeyDelegator]).
+			WriteText(" completes unbonding ").
+			WriteCoins(amount, m.GetBankDenomsMetadata(amount)).
+			WriteText(" from ").
+			WriteAddress(attrs[stakingtypes.AttributeKeyValidator])
+	case stakingtypes.EventTypeCompleteRedelegation:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteAddress(attrs[stakingtypes.AttributeKeyDelegator]).
+			WriteText(" completes re-delegation ").
+			WriteCoins(amount, m.GetBankDenomsMetadata(amount)).
+			WriteText(" from ").
+			WriteAddress(attrs[stakingtypes.AttributeKeySrcValidator]).
+			WriteText(" to ").
+			WriteAddress(attrs[stakingtypes.AttributeKeyDstValidator])
+	case govtypes.EventTypeActiveProposal, govtypes.EventTypeInactiveProposal:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteText("Proposal ").
+			WriteText(attrs[govtypes.AttributeKeyProposalID]).
+			WriteText(" finished with result ").
+			WriteText(attrs[govtypes.AttributeKeyProposalResult])
+	case channeltypes.EventTypeTimeoutPacket:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteText("IBC packet ").
+			WriteText(attrs[channeltypes.AttributeKeySequence]).
+			WriteText(" via ").
+			WriteText(attrs[channeltypes.AttributeKeySrcPort]).WriteText("/").WriteText(attrs[channeltypes.AttributeKeySrcChannel]).
+			WriteText(" timed out")
+	case banktypes.EventTypeTransfer:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteAddress(attrs[banktypes.AttributeKeySender]).
+			WriteText(" transfers ").
+			WriteCoins(amount, m.GetBankDenomsMetadata(amount)).
+			WriteText(" to ").
+			WriteAddress(attrs[banktypes.AttributeKeyRecipient])
+	case banktypes.EventTypeCoinBurn:
+		return berpctypes.NewFriendlyResponseContentBuilder().
+			WriteAddress(attrs[banktypes.AttributeKeyBurner]).
+			WriteText(" burns ").
+			WriteCoins(amount, m.GetBankDenomsMetadata(amount))
+	}
+
+	return nil
+}
+
+// getMintDenom returns the denom of the minted coins, from the mint module params.
+func (m *Backend) getMintDenom() (string, error) {
+	resParams, err := m.queryClient.MintQueryClient.Params(m.ctx, &minttypes.QueryParamsRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	if resParams.Params.MintDenom == "" {
+		return "", fmt.Errorf("mint denom is empty")
+	}
+
+	return resParams.Params.MintDenom, nil
+}
@@ -2,10 +2,12 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	berpcutils "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	"github.com/tendermint/tendermint/rpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"sync"
@@ -110,14 +112,25 @@ func (vc *tendermintValidatorsCache) isCacheExpired(lock bool) (expired bool, la
 // reloadCacheWithoutLock performs reload cache. Lock acquire must be performed before calling this.
 func (vc *tendermintValidatorsCache) reloadCacheWithoutLock(height int64) error {
 	var page = 1
-	var perPage = 200
+	var perPage = 100 // maximum page size allowed by Tendermint
 
-	resValidators, err := vc.tmClient.Validators(context.Background(), &height, &page, &perPage)
-	if err != nil {
-		return err
+	validators := make([]*tmtypes.Validator, 0)
+	for {
+		resValidators, err := vc.tmClient.Validators(context.Background(), &height, &page, &perPage)
+		if err != nil {
+			return err
+		}
+
+		validators = append(validators, resValidators.Validators...)
+
+		if len(resValidators.Validators) < perPage || len(validators) >= resValidators.Total {
+			break
+		}
+
+		page++
 	}
 
-	vc.validators = resValidators.Validators
+	vc.validators = validators
 	vc.cacheController.UpdateExpirationAnchor(height + validatorsCacheExpiration)
 
 	return nil
@@ -205,29 +218,37 @@ func (vc *stakingValidatorsCache) isCacheExpired(lock bool) (expired bool, lates
 func (vc *stakingValidatorsCache) reloadCacheWithoutLock(height int64) error {
 	var perPage = 200
 
-	stakingVals, errStakingVals := vc.stakingQueryClient.Validators(context.Background(), &stakingtypes.QueryValidatorsRequest{
-		Pagination: &query.PageRequest{
-			Offset: 0,
-			Limit:  uint64(perPage),
-		},
-	})
-	if errStakingVals != nil {
-		return errStakingVals
-	}
-
 	cachedValidators := make([]cachedValidator, 0)
 
-	for _, val := range stakingVals.Validators {
-		consAddr, success := berpcutils.FromAnyPubKeyToConsensusAddress(val.ConsensusPubkey, vc.codec)
-		if !success {
-			continue
+	var nextKey []byte
+	for {
+		stakingVals, errStakingVals := vc.stakingQueryClient.Validators(context.Background(), &stakingtypes.QueryValidatorsRequest{
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: uint64(perPage),
+			},
+		})
+		if errStakingVals != nil {
+			return errStakingVals
 		}
 
-		consAddrStr := consAddr.String()
-		cachedValidators = append(cachedValidators, cachedValidator{
-			consAddr:  consAddrStr,
-			validator: val,
-		})
+		for _, val := range stakingVals.Validators {
+			consAddr, success := berpcutils.FromAnyPubKeyToConsensusAddress(val.ConsensusPubkey, vc.codec)
+			if !success {
+				continue
+			}
+
+			consAddrStr := consAddr.String()
+			cachedValidators = append(cachedValidators, cachedValidator{
+				consAddr:  consAddrStr,
+				validator: val,
+			})
+		}
+
+		if stakingVals.Pagination == nil || len(stakingVals.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = stakingVals.Pagination.NextKey
 	}
 
 	vc.validators = cachedValidators
@@ -235,3 +256,203 @@ func (vc *stakingValidatorsCache) reloadCacheWithoutLock(height int64) error {
 
 	return nil
 }
+
+// ibcDenomTracesCache caches the IBC denom traces by IBC denom (ibc/<hash>).
+// Denom traces are immutable so cached records never expire.
+type ibcDenomTracesCache struct {
+	rwMutex                *sync.RWMutex
+	denomTraces            map[string]ibctransfertypes.DenomTrace
+	ibcTransferQueryClient ibctransfertypes.QueryClient
+}
+
+func NewIbcDenomTracesCache(ibcTransferQueryClient ibctransfertypes.QueryClient) *ibcDenomTracesCache {
+	return &ibcDenomTracesCache{
+		rwMutex:                &sync.RWMutex{},
+		denomTraces:            make(map[string]ibctransfertypes.DenomTrace),
+		ibcTransferQueryClient: ibcTransferQueryClient,
+	}
+}
+
+// GetDenomTrace returns the denom trace of the IBC denom (ibc/<hash>), query and cache if not yet cached.
+func (dc *ibcDenomTracesCache) GetDenomTrace(ibcDenom string) (denomTrace ibctransfertypes.DenomTrace, err error) {
+	dc.rwMutex.RLock()
+	denomTrace, found := dc.denomTraces[ibcDenom]
+	dc.rwMutex.RUnlock()
+
+	if found {
+		return
+	}
+
+	resDenomTrace, err := dc.ibcTransferQueryClient.DenomTrace(context.Background(), &ibctransfertypes.QueryDenomTraceRequest{
+		Hash: ibcDenom,
+	})
+	if err != nil {
+		return
+	}
+	if resDenomTrace.DenomTrace == nil {
+		err = fmt.Errorf("denom trace not found for %s", ibcDenom)
+		return
+	}
+
+	denomTrace = *resDenomTrace.DenomTrace
+
+	dc.rwMutex.Lock()
+	defer dc.rwMutex.Unlock()
+	dc.denomTraces[ibcDenom] = denomTrace
+
+	return
+}
+
+// validatorRecordsCacheExpiration is the number of blocks the cached records of a validator are valid for.
+const validatorRecordsCacheExpiration = 20
+
+// validatorRecordsCacheMaxEntries is the maximum number of cached entries, the oldest entry is evicted when full.
+const validatorRecordsCacheMaxEntries = 30
+
+// validatorRecordsCache caches the sorted delegations, unbonding delegations and re-delegations of validators,
+// so paging through the records does not reload all of them.
+// Entries expire after a number of blocks, concurrent loads of the same entry are merged into a single load.
+type validatorRecordsCache struct {
+	mutex   *sync.Mutex
+	entries map[string]*validatorRecordsCacheEntry
+	loading map[string]*validatorRecordsLoadCall
+}
+
+// validatorRecordsCacheEntry holds one type of records of a validator, the other fields are empty.
+type validatorRecordsCacheEntry struct {
+	loadedHeight         int64
+	delegations          []stakingtypes.DelegationResponse
+	unbondingDelegations []stakingtypes.UnbondingDelegation
+	redelegations        []stakingtypes.RedelegationResponse
+}
+
+type validatorRecordsLoadCall struct {
+	done  chan struct{}
+	entry *validatorRecordsCacheEntry
+	err   error
+}
+
+func NewValidatorRecordsCache() *validatorRecordsCache {
+	return &validatorRecordsCache{
+		mutex:   &sync.Mutex{},
+		entries: make(map[string]*validatorRecordsCacheEntry),
+		loading: make(map[string]*validatorRecordsLoadCall),
+	}
+}
+
+// GetDelegations returns the cached sorted delegations of the validator, load using the loader if not cached or expired.
+func (rc *validatorRecordsCache) GetDelegations(valAddr string, latestHeight int64, loader func() ([]stakingtypes.DelegationResponse, error)) ([]stakingtypes.DelegationResponse, error) {
+	entry, err := rc.getOrLoad("delegations/"+valAddr, latestHeight, func() (*validatorRecordsCacheEntry, error) {
+		delegations, err := loader()
+		if err != nil {
+			return nil, err
+		}
+		return &validatorRecordsCacheEntry{delegations: delegations}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.delegations, nil
+}
+
+// GetUnbondingDelegations returns the cached sorted unbonding delegations of the validator,
+// load using the loader if not cached or expired.
+func (rc *validatorRecordsCache) GetUnbondingDelegations(valAddr string, latestHeight int64, loader func() ([]stakingtypes.UnbondingDelegation, error)) ([]stakingtypes.UnbondingDelegation, error) {
+	entry, err := rc.getOrLoad("unbonding-delegations/"+valAddr, latestHeight, func() (*validatorRecordsCacheEntry, error) {
+		unbondingDelegations, err := loader()
+		if err != nil {
+			return nil, err
+		}
+		return &validatorRecordsCacheEntry{unbondingDelegations: unbondingDelegations}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.unbondingDelegations, nil
+}
+
+// GetRedelegations returns the cached sorted re-delegations of the validator, load using the loader if not cached or expired.
+func (rc *validatorRecordsCache) GetRedelegations(valAddr string, latestHeight int64, loader func() ([]stakingtypes.RedelegationResponse, error)) ([]stakingtypes.RedelegationResponse, error) {
+	entry, err := rc.getOrLoad("redelegations/"+valAddr, latestHeight, func() (*validatorRecordsCacheEntry, error) {
+		redelegations, err := loader()
+		if err != nil {
+			return nil, err
+		}
+		return &validatorRecordsCacheEntry{redelegations: redelegations}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry.redelegations, nil
+}
+
+func (rc *validatorRecordsCache) getOrLoad(key string, latestHeight int64, loader func() (*validatorRecordsCacheEntry, error)) (*validatorRecordsCacheEntry, error) {
+	rc.mutex.Lock()
+	if entry, found := rc.entries[key]; found && !entry.isExpired(latestHeight) {
+		rc.mutex.Unlock()
+		return entry, nil
+	}
+	if call, loading := rc.loading[key]; loading {
+		rc.mutex.Unlock()
+		<-call.done
+		return call.entry, call.err
+	}
+	call := &validatorRecordsLoadCall{
+		done: make(chan struct{}),
+	}
+	rc.loading[key] = call
+	rc.mutex.Unlock()
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				call.err = fmt.Errorf("panic while loading validator records: %v", r)
+			}
+
+			rc.mutex.Lock()
+			delete(rc.loading, key)
+			if call.err == nil {
+				rc.setWithoutLock(key, call.entry, latestHeight)
+			}
+			rc.mutex.Unlock()
+
+			close(call.done)
+		}()
+
+		call.entry, call.err = loader()
+		if call.err == nil {
+			call.entry.loadedHeight = latestHeight
+		}
+	}()
+
+	return call.entry, call.err
+}
+
+// setWithoutLock stores the entry, evicts expired entries and the oldest entries if the cache is full.
+// Lock acquire must be performed before calling this.
+func (rc *validatorRecordsCache) setWithoutLock(key string, entry *validatorRecordsCacheEntry, latestHeight int64) {
+	for existingKey, existingEntry := range rc.entries {
+		if existingEntry.isExpired(latestHeight) {
+			delete(rc.entries, existingKey)
+		}
+	}
+
+	delete(rc.entries, key)
+	for len(rc.entries) >= validatorRecordsCacheMaxEntries {
+		var oldestKey string
+		var oldestHeight int64
+		for existingKey, existingEntry := range rc.entries {
+			if oldestKey == "" || existingEntry.loadedHeight < oldestHeight {
+				oldestKey = existingKey
+				oldestHeight = existingEntry.loadedHeight
+			}
+		}
+		delete(rc.entries, oldestKey)
+	}
+
+	rc.entries[key] = entry
+}
+
+func (e *validatorRecordsCacheEntry) isExpired(latestHeight int64) bool {
+	return latestHeight > e.loadedHeight+validatorRecordsCacheExpiration
+}
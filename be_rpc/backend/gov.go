@@ -1,12 +1,27 @@
 package backend
 
 import (
+	"fmt"
 	berpctypes "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/types"
 	berpcutils "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func (m *Backend) GetGovProposal(proposalId uint64) (berpctypes.GenericBackendResponse, error) {
@@ -14,41 +29,833 @@ func (m *Backend) GetGovProposal(proposalId uint64) (berpctypes.GenericBackendRe
 		return nil, berpctypes.ErrBadRequest
 	}
 
+	proposal, err := m.getGovProposal(proposalId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	proposalInfo := proposalToMap(proposal, m.clientCtx.Codec)
+
+	if proposal.Status == govv1types.StatusVotingPeriod {
+		tallyingContext, err := m.getGovTallyingContext()
+		if err != nil {
+			return nil, err
+		}
+
+		liveTally, err := m.getLiveTally(proposal, tallyingContext)
+		if err != nil {
+			return nil, err
+		}
+		proposalInfo["liveTally"] = liveTally
+	}
+
+	return proposalInfo, nil
+}
+
+const (
+	defaultGovProposalsPageSize = 20
+	maxGovProposalsPageSize     = 100
+)
+
+func (m *Backend) GetGovProposals(pageNo, pageSize int, filter berpctypes.GovProposalsFilter) (berpctypes.GenericBackendResponse, error) {
+	if pageNo < 1 {
+		return nil, berpctypes.ErrBadPageNo
+	}
+	if pageSize == 0 {
+		pageSize = defaultGovProposalsPageSize
+	}
+	if pageSize < 1 || pageSize > maxGovProposalsPageSize {
+		return nil, berpctypes.ErrBadPageSize
+	}
+
+	var proposalStatus govv1types.ProposalStatus
+	if filter.Status = strings.TrimSpace(filter.Status); filter.Status != "" {
+		var found bool
+		proposalStatus, found = parseProposalStatus(filter.Status)
+		if !found {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("not supported proposal status %s", filter.Status))
+		}
+	}
+
+	normalizeAddressFilter := func(addr string) (string, error) {
+		addr = berpcutils.NormalizeAddress(addr)
+		if addr == "" {
+			return "", nil
+		}
+		addr = m.bech32Cfg.ConvertToAccAddressIfHexOtherwiseKeepAsIs(addr)
+		if !m.bech32Cfg.IsAccountAddr(addr) {
+			return "", berpctypes.ErrBadAddress
+		}
+		return addr, nil
+	}
+
+	proposer, err := normalizeAddressFilter(filter.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	depositor, err := normalizeAddressFilter(filter.Depositor)
+	if err != nil {
+		return nil, err
+	}
+	voter, err := normalizeAddressFilter(filter.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*govv1types.Proposal
+	var total int
+
+	if proposer != "" {
+		if depositor != "" || voter != "" {
+			return nil, status.Error(codes.InvalidArgument, "proposer filter can not be combined with depositor or voter filter")
+		}
+
+		proposals, total, err = m.getGovProposalsSubmittedBy(proposer, proposalStatus, pageNo, pageSize)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		pagination := getPagination(pageNo, pageSize)
+		pagination.CountTotal = true
+
+		var resPagination *query.PageResponse
+		proposals, resPagination, err = m.getGovProposals(proposalStatus, depositor, voter, pagination)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if resPagination != nil {
+			total = int(resPagination.Total)
+		}
+	}
+
+	var tallyingContext *govTallyingContext
+
+	proposalsInfo := make(map[uint64]any, 0)
+	for _, proposal := range proposals {
+		proposalInfo := proposalToMap(proposal, m.clientCtx.Codec)
+
+		if proposal.Status == govv1types.StatusVotingPeriod {
+			if tallyingContext == nil {
+				tallyingContext, err = m.getGovTallyingContext()
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			liveTally, err := m.getLiveTally(proposal, tallyingContext)
+			if err != nil {
+				return nil, err
+			}
+			proposalInfo["liveTally"] = liveTally
+		}
+
+		proposalsInfo[proposal.Id] = proposalInfo
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"proposals": proposalsInfo,
+		"total":     total,
+		"pageNo":    pageNo,
+		"pageSize":  pageSize,
+	}, nil
+}
+
+// getGovProposal returns the proposal using gov v1 query,
+// fallback to gov v1beta1 query for chains where v1 queries are not available.
+func (m *Backend) getGovProposal(proposalId uint64) (*govv1types.Proposal, error) {
 	resProposal, err := m.queryClient.GovV1QueryClient.Proposal(m.ctx, &govv1types.QueryProposalRequest{
 		ProposalId: proposalId,
 	})
+	if err == nil {
+		return resProposal.Proposal, nil
+	}
+
+	resLegacyProposal, errLegacy := m.queryClient.GovV1Beta1QueryClient.Proposal(m.ctx, &govv1beta1types.QueryProposalRequest{
+		ProposalId: proposalId,
+	})
+	if errLegacy != nil {
+		return nil, err
+	}
+
+	return convertLegacyProposal(resLegacyProposal.Proposal), nil
+}
+
+// getGovProposals returns the proposals using gov v1 query,
+// fallback to gov v1beta1 query for chains where v1 queries are not available.
+func (m *Backend) getGovProposals(proposalStatus govv1types.ProposalStatus, depositor, voter string, pagination *query.PageRequest) ([]*govv1types.Proposal, *query.PageResponse, error) {
+	resProposals, err := m.queryClient.GovV1QueryClient.Proposals(m.ctx, &govv1types.QueryProposalsRequest{
+		ProposalStatus: proposalStatus,
+		Depositor:      depositor,
+		Voter:          voter,
+		Pagination:     pagination,
+	})
+	if err == nil {
+		return resProposals.Proposals, resProposals.Pagination, nil
+	}
+
+	resLegacyProposals, errLegacy := m.queryClient.GovV1Beta1QueryClient.Proposals(m.ctx, &govv1beta1types.QueryProposalsRequest{
+		ProposalStatus: govv1beta1types.ProposalStatus(proposalStatus),
+		Depositor:      depositor,
+		Voter:          voter,
+		Pagination:     pagination,
+	})
+	if errLegacy != nil {
+		return nil, nil, err
+	}
+
+	proposals := make([]*govv1types.Proposal, 0, len(resLegacyProposals.Proposals))
+	for _, legacyProposal := range resLegacyProposals.Proposals {
+		proposals = append(proposals, convertLegacyProposal(legacyProposal))
+	}
+
+	return proposals, resLegacyProposals.Pagination, nil
+}
+
+// getGovProposalsSubmittedBy returns the proposals submitted by the given account, newest first,
+// found by searching the submit proposal transactions from the tx index.
+func (m *Backend) getGovProposalsSubmittedBy(proposer string, proposalStatus govv1types.ProposalStatus, pageNo, pageSize int) ([]*govv1types.Proposal, int, error) {
+	txSearchQuery := fmt.Sprintf("message.sender='%s' AND submit_proposal.proposal_id EXISTS", proposer)
+
+	proposalIds := make([]uint64, 0)
+	uniqueProposalIds := make(map[uint64]bool)
+
+	err := m.forEachEventOfTxSearch(txSearchQuery, govtypes.EventTypeSubmitProposal, func(attributes map[string]string) {
+		proposalId, err := strconv.ParseUint(attributes[govtypes.AttributeKeyProposalID], 10, 64)
+		if err != nil || uniqueProposalIds[proposalId] {
+			return
+		}
+
+		uniqueProposalIds[proposalId] = true
+		proposalIds = append(proposalIds, proposalId)
+	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, 0, errors.Wrap(err, "failed to search submit proposal transactions")
+	}
+
+	sort.Slice(proposalIds, func(i, j int) bool {
+		return proposalIds[i] > proposalIds[j]
+	})
+
+	proposals := make([]*govv1types.Proposal, 0)
+	for _, proposalId := range proposalIds {
+		proposal, err := m.getGovProposal(proposalId)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, fmt.Sprintf("failed to get proposal %d", proposalId))
+		}
+
+		if proposalStatus != govv1types.StatusNil && proposal.Status != proposalStatus {
+			continue
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	from, to := getPageRange(pageNo, pageSize, len(proposals))
+	return proposals[from:to], len(proposals), nil
+}
+
+// forEachEventOfTxSearch searches transactions from the tx index, from the oldest to the latest,
+// and invokes the handler for each event of the given type found in the transactions results.
+func (m *Backend) forEachEventOfTxSearch(txSearchQuery, eventType string, handler func(attributes map[string]string)) error {
+	return m.forEachTxOfTxSearch(txSearchQuery, func(events []abci.Event) {
+		for _, event := range events {
+			if event.Type != eventType {
+				continue
+			}
+
+			handler(eventAttributesToMap(event))
+		}
+	})
+}
+
+// forEachTxOfTxSearch searches transactions from the tx index, from the oldest to the latest,
+// and invokes the handler with the events of each transaction result.
+func (m *Backend) forEachTxOfTxSearch(txSearchQuery string, handler func(events []abci.Event)) error {
+	var page = 1
+	var perPage = 100
+	for {
+		resTxSearch, err := m.clientCtx.Client.TxSearch(m.ctx, txSearchQuery, false, &page, &perPage, "asc")
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range resTxSearch.Txs {
+			handler(tx.TxResult.Events)
+		}
+
+		if len(resTxSearch.Txs) < perPage || page*perPage >= resTxSearch.TotalCount {
+			return nil
+		}
+
+		page++
+	}
+}
+
+// govAttributeKeyVoter is the voter attribute of the proposal_vote event, emitted by newer versions of gov module.
+const govAttributeKeyVoter = "voter"
+
+// forEachProposalVoteOfTxSearch searches transactions from the tx index, from the oldest to the latest,
+// and invokes the handler for each proposal vote event found in the transactions results, along with the voter.
+//
+// The proposal_vote event of gov module does not carry the voter, so the voter is taken from the message event
+// emitted by gov module right after the vote, which contains the voter as sender.
+// That message event is also emitted for votes executed via authz MsgExec, where the voter is not the tx signer.
+func (m *Backend) forEachProposalVoteOfTxSearch(txSearchQuery string, handler func(voter string, attributes map[string]string)) error {
+	return m.forEachTxOfTxSearch(txSearchQuery, func(events []abci.Event) {
+		var pendingVotes []map[string]string
+		for _, event := range events {
+			switch event.Type {
+			case govtypes.EventTypeProposalVote:
+				attributes := eventAttributesToMap(event)
+				if voter := attributes[govAttributeKeyVoter]; voter != "" {
+					// newer versions of gov module emit the voter
+					handler(voter, attributes)
+					continue
+				}
+
+				pendingVotes = append(pendingVotes, attributes)
+			case sdk.EventTypeMessage:
+				if len(pendingVotes) < 1 {
+					continue
+				}
+
+				attributes := eventAttributesToMap(event)
+				if attributes[sdk.AttributeKeyModule] != govtypes.AttributeValueCategory {
+					continue
+				}
+				voter := attributes[sdk.AttributeKeySender]
+				if voter == "" {
+					continue
+				}
+
+				for _, voteAttributes := range pendingVotes {
+					handler(voter, voteAttributes)
+				}
+				pendingVotes = nil
+			}
+		}
+	})
+}
+
+func eventAttributesToMap(event abci.Event) map[string]string {
+	attributes := make(map[string]string)
+	for _, attribute := range event.Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+	return attributes
+}
+
+func (m *Backend) GetValidatorGovParticipation(valAddr string) (berpctypes.GenericBackendResponse, error) {
+	valAddr = berpcutils.NormalizeAddress(valAddr)
+	if !m.bech32Cfg.IsValAddr(valAddr) {
+		return nil, berpctypes.ErrBadAddress
+	}
+
+	validator, err := m.findStakingValidator(valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	accAddr := m.bech32Cfg.FromAnyToBech32AccountAddrUnsafe(valAddr)
+
+	// collect all proposals that reached voting period
+	proposals := make([]*govv1types.Proposal, 0)
+	var nextKey []byte
+	for {
+		pageProposals, resPagination, err := m.getGovProposals(govv1types.StatusNil, "", "", &query.PageRequest{
+			Key:   nextKey,
+			Limit: govQueryPageSize,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get proposals").Error())
+		}
+
+		for _, proposal := range pageProposals {
+			if proposal.Status == govv1types.StatusNil || proposal.Status == govv1types.StatusDepositPeriod {
+				continue
+			}
+			proposals = append(proposals, proposal)
+		}
+
+		if resPagination == nil || len(resPagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resPagination.NextKey
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].Id > proposals[j].Id
+	})
+
+	// votes are pruned from state after the voting period ends, so find them from the tx index,
+	// the latest vote of each proposal takes effect.
+	// Votes executed via authz are also matched, because gov module emits the voter as message sender.
+	voteOptionsByProposalId := make(map[uint64]govv1types.WeightedVoteOptions)
+	txSearchQuery := fmt.Sprintf("message.sender='%s' AND proposal_vote.proposal_id EXISTS", accAddr)
+	err = m.forEachProposalVoteOfTxSearch(txSearchQuery, func(voter string, attributes map[string]string) {
+		if voter != accAddr {
+			// other voters in the same tx
+			return
+		}
+
+		proposalId, err := strconv.ParseUint(attributes[govtypes.AttributeKeyProposalID], 10, 64)
+		if err != nil {
+			return
+		}
+
+		options, ok := berpcutils.ParseVoteOptionsFromEvent(attributes[govtypes.AttributeKeyOption])
+		if !ok {
+			m.GetLogger().Error("failed to parse vote options", "proposal-id", proposalId, "option", attributes[govtypes.AttributeKeyOption])
+			return
+		}
+
+		voteOptionsByProposalId[proposalId] = options
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to search vote transactions").Error())
+	}
+
+	var votedCount int
+	proposalsInfo := make([]berpctypes.GenericBackendResponse, 0, len(proposals))
+	for _, proposal := range proposals {
+		proposalInfo := berpctypes.GenericBackendResponse{
+			"id":     proposal.Id,
+			"status": proposal.Status.String(),
+		}
+
+		options, voted := voteOptionsByProposalId[proposal.Id]
+
+		if proposal.Status == govv1types.StatusVotingPeriod {
+			// state is the source of truth for proposals in voting period
+			resVote, err := m.queryClient.GovV1QueryClient.Vote(m.ctx, &govv1types.QueryVoteRequest{
+				ProposalId: proposal.Id,
+				Voter:      accAddr,
+			})
+			if err == nil && resVote.Vote != nil {
+				voted = true
+				options = resVote.Vote.Options
+			}
+		}
+
+		proposalInfo["voted"] = voted
+		if voted {
+			votedCount++
+			proposalInfo["options"] = weightedVoteOptionsToResponse(options)
+		}
+
+		proposalsInfo = append(proposalsInfo, proposalInfo)
+	}
+
+	var participationRate float64
+	if len(proposals) > 0 {
+		participationRate = math.Round(float64(votedCount)*100*100/float64(len(proposals))) / 100
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"validator":         valAddr,
+		"moniker":           validator.validator.Description.Moniker,
+		"voter":             accAddr,
+		"proposals":         proposalsInfo,
+		"votedCount":        votedCount,
+		"proposalsCount":    len(proposals),
+		"participationRate": participationRate,
+	}, nil
+}
+
+// convertLegacyProposal converts the gov v1beta1 proposal into gov v1 proposal,
+// the content is wrapped into MsgExecLegacyContent.
+func convertLegacyProposal(legacyProposal govv1beta1types.Proposal) *govv1types.Proposal {
+	proposal := &govv1types.Proposal{
+		Id:     legacyProposal.ProposalId,
+		Status: govv1types.ProposalStatus(legacyProposal.Status),
+		FinalTallyResult: &govv1types.TallyResult{
+			YesCount:        legacyProposal.FinalTallyResult.Yes.String(),
+			AbstainCount:    legacyProposal.FinalTallyResult.Abstain.String(),
+			NoCount:         legacyProposal.FinalTallyResult.No.String(),
+			NoWithVetoCount: legacyProposal.FinalTallyResult.NoWithVeto.String(),
+		},
+		SubmitTime:      &legacyProposal.SubmitTime,
+		DepositEndTime:  &legacyProposal.DepositEndTime,
+		TotalDeposit:    legacyProposal.TotalDeposit,
+		VotingStartTime: &legacyProposal.VotingStartTime,
+		VotingEndTime:   &legacyProposal.VotingEndTime,
+	}
+
+	if legacyProposal.Content != nil {
+		msg := govv1types.NewMsgExecLegacyContent(legacyProposal.Content, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		if err == nil {
+			proposal.Messages = []*codectypes.Any{msgAny}
+		}
 	}
 
-	return proposalToMap(resProposal.Proposal, m.clientCtx.Codec), nil
+	return proposal
+}
+
+// parseProposalStatus parses the proposal status from the short form (deposit, voting, passed, rejected, failed)
+// or the proto enum name (PROPOSAL_STATUS_DEPOSIT_PERIOD,...).
+func parseProposalStatus(proposalStatus string) (govv1types.ProposalStatus, bool) {
+	switch strings.ToLower(proposalStatus) {
+	case "deposit", "deposit_period", "proposal_status_deposit_period":
+		return govv1types.StatusDepositPeriod, true
+	case "voting", "voting_period", "proposal_status_voting_period":
+		return govv1types.StatusVotingPeriod, true
+	case "passed", "proposal_status_passed":
+		return govv1types.StatusPassed, true
+	case "rejected", "proposal_status_rejected":
+		return govv1types.StatusRejected, true
+	case "failed", "proposal_status_failed":
+		return govv1types.StatusFailed, true
+	default:
+		return govv1types.StatusNil, false
+	}
+}
+
+// govTallyingContext holds the information needed to project the outcome of proposals in voting period.
+type govTallyingContext struct {
+	quorum        sdk.Dec
+	threshold     sdk.Dec
+	vetoThreshold sdk.Dec
+	bondedTokens  sdk.Int
 }
 
-func (m *Backend) GetGovProposals(pageNo int) (berpctypes.GenericBackendResponse, error) {
+// getGovTallyingContext returns the gov tally params and the current bonded tokens.
+func (m *Backend) getGovTallyingContext() (*govTallyingContext, error) {
+	var tallyParams *govv1types.TallyParams
+	resTallyParams, err := m.queryClient.GovV1QueryClient.Params(m.ctx, &govv1types.QueryParamsRequest{
+		ParamsType: govv1types.ParamTallying,
+	})
+	if err == nil {
+		tallyParams = resTallyParams.TallyParams
+	} else {
+		resLegacyTallyParams, errLegacy := m.queryClient.GovV1Beta1QueryClient.Params(m.ctx, &govv1beta1types.QueryParamsRequest{
+			ParamsType: govv1types.ParamTallying,
+		})
+		if errLegacy != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get gov tallying params").Error())
+		}
+		tallyParams = &govv1types.TallyParams{
+			Quorum:        resLegacyTallyParams.TallyParams.Quorum.String(),
+			Threshold:     resLegacyTallyParams.TallyParams.Threshold.String(),
+			VetoThreshold: resLegacyTallyParams.TallyParams.VetoThreshold.String(),
+		}
+	}
+	if tallyParams == nil {
+		return nil, status.Error(codes.Internal, "gov tallying params is empty")
+	}
+
+	resPool, err := m.queryClient.StakingQueryClient.Pool(m.ctx, &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking pool").Error())
+	}
+
+	quorum, err := sdk.NewDecFromStr(tallyParams.Quorum)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to parse quorum").Error())
+	}
+	threshold, err := sdk.NewDecFromStr(tallyParams.Threshold)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to parse threshold").Error())
+	}
+	vetoThreshold, err := sdk.NewDecFromStr(tallyParams.VetoThreshold)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to parse veto threshold").Error())
+	}
+
+	return &govTallyingContext{
+		quorum:        quorum,
+		threshold:     threshold,
+		vetoThreshold: vetoThreshold,
+		bondedTokens:  resPool.Pool.BondedTokens,
+	}, nil
+}
+
+// getLiveTally returns the current tally of a proposal in voting period,
+// with turnout, quorum status and the projected outcome if voting ended now.
+func (m *Backend) getLiveTally(proposal *govv1types.Proposal, tallyingContext *govTallyingContext) (map[string]any, error) {
+	var tally *govv1types.TallyResult
+	resTally, err := m.queryClient.GovV1QueryClient.TallyResult(m.ctx, &govv1types.QueryTallyResultRequest{
+		ProposalId: proposal.Id,
+	})
+	if err == nil {
+		tally = resTally.Tally
+	} else {
+		resLegacyTally, errLegacy := m.queryClient.GovV1Beta1QueryClient.TallyResult(m.ctx, &govv1beta1types.QueryTallyResultRequest{
+			ProposalId: proposal.Id,
+		})
+		if errLegacy != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get tally result").Error())
+		}
+		tally = &govv1types.TallyResult{
+			YesCount:        resLegacyTally.Tally.Yes.String(),
+			AbstainCount:    resLegacyTally.Tally.Abstain.String(),
+			NoCount:         resLegacyTally.Tally.No.String(),
+			NoWithVetoCount: resLegacyTally.Tally.NoWithVeto.String(),
+		}
+	}
+
+	if tally == nil {
+		emptyTally := govv1types.EmptyTallyResult()
+		tally = &emptyTally
+	}
+
+	parseCount := func(count string) sdk.Dec {
+		amount, ok := sdk.NewIntFromString(count)
+		if !ok {
+			return sdk.ZeroDec()
+		}
+		return sdk.NewDecFromInt(amount)
+	}
+
+	yes := parseCount(tally.YesCount)
+	abstain := parseCount(tally.AbstainCount)
+	no := parseCount(tally.NoCount)
+	noWithVeto := parseCount(tally.NoWithVetoCount)
+	totalVoted := yes.Add(abstain).Add(no).Add(noWithVeto)
+
+	turnout := sdk.ZeroDec()
+	if tallyingContext.bondedTokens.IsPositive() {
+		turnout = totalVoted.QuoInt(tallyingContext.bondedTokens)
+	}
+	quorumReached := turnout.GTE(tallyingContext.quorum)
+
+	// projection follows the same rules as the gov module tallying
+	var projectedOutcome string
+	if !quorumReached {
+		projectedOutcome = "rejected: quorum not reached"
+	} else if nonAbstain := totalVoted.Sub(abstain); !nonAbstain.IsPositive() {
+		projectedOutcome = "rejected: all voted abstain"
+	} else if noWithVeto.Quo(totalVoted).GT(tallyingContext.vetoThreshold) {
+		projectedOutcome = "rejected: vetoed"
+	} else if yes.Quo(nonAbstain).GT(tallyingContext.threshold) {
+		projectedOutcome = "passed"
+	} else {
+		projectedOutcome = "rejected: threshold not reached"
+	}
+
+	liveTally := map[string]any{
+		"yes":              tally.YesCount,
+		"abstain":          tally.AbstainCount,
+		"no":               tally.NoCount,
+		"noWithVeto":       tally.NoWithVetoCount,
+		"bondedTokens":     tallyingContext.bondedTokens.String(),
+		"turnout":          turnout.String(),
+		"quorum":           tallyingContext.quorum.String(),
+		"threshold":        tallyingContext.threshold.String(),
+		"vetoThreshold":    tallyingContext.vetoThreshold.String(),
+		"quorumReached":    quorumReached,
+		"projectedOutcome": projectedOutcome,
+	}
+
+	if proposal.VotingEndTime != nil {
+		timeRemaining := proposal.VotingEndTime.Sub(time.Now().UTC())
+		if timeRemaining < 0 {
+			timeRemaining = 0
+		}
+		liveTally["timeRemainingSeconds"] = int64(timeRemaining.Seconds())
+	}
+
+	return liveTally, nil
+}
+
+// govQueryPageSize is the page size used to fetch all records of a proposal from gov module,
+// before filtering and paginating them.
+const govQueryPageSize = 1000
+
+func (m *Backend) GetGovProposalVotes(proposalId uint64, pageNo int, option string) (berpctypes.GenericBackendResponse, error) {
+	if proposalId < 1 {
+		return nil, berpctypes.ErrBadRequest
+	}
 	if pageNo < 1 {
 		return nil, berpctypes.ErrBadPageNo
 	}
 
-	resProposals, err := m.queryClient.GovV1QueryClient.Proposals(m.ctx, &govv1types.QueryProposalsRequest{
-		ProposalStatus: 0,
-		Pagination:     getDefaultPagination(pageNo),
+	var optionFilter govv1types.VoteOption
+	if option = strings.TrimSpace(option); option != "" {
+		var found bool
+		optionFilter, found = parseVoteOption(option)
+		if !found {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("not supported vote option %s", option))
+		}
+	}
+
+	var votes []*govv1types.Vote
+	var total int
+
+	if optionFilter == govv1types.OptionEmpty {
+		pagination := getDefaultPagination(pageNo)
+		pagination.CountTotal = true
+
+		resVotes, err := m.queryClient.GovV1QueryClient.Votes(m.ctx, &govv1types.QueryVotesRequest{
+			ProposalId: proposalId,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get proposal votes").Error())
+		}
+
+		votes = resVotes.Votes
+		if resVotes.Pagination != nil {
+			total = int(resVotes.Pagination.Total)
+		}
+	} else {
+		// vote option can not be filtered by the query, so fetch all then filter
+		filteredVotes := make([]*govv1types.Vote, 0)
+
+		var nextKey []byte
+		for {
+			resVotes, err := m.queryClient.GovV1QueryClient.Votes(m.ctx, &govv1types.QueryVotesRequest{
+				ProposalId: proposalId,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: govQueryPageSize,
+				},
+			})
+			if err != nil {
+				return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get proposal votes").Error())
+			}
+
+			for _, vote := range resVotes.Votes {
+				if isVotedFor(vote, optionFilter) {
+					filteredVotes = append(filteredVotes, vote)
+				}
+			}
+
+			if resVotes.Pagination == nil || len(resVotes.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = resVotes.Pagination.NextKey
+		}
+
+		total = len(filteredVotes)
+		from, to := getPageRange(pageNo, defaultPageSize, total)
+		votes = filteredVotes[from:to]
+	}
+
+	validatorByAccAddr := m.getValidatorsByAccountAddress()
+
+	votesInfo := make([]berpctypes.GenericBackendResponse, 0, len(votes))
+	for _, vote := range votes {
+		voteInfo := berpctypes.GenericBackendResponse{
+			"voter":   vote.Voter,
+			"options": weightedVoteOptionsToResponse(vote.Options),
+		}
+		if vote.Metadata != "" {
+			voteInfo["metadata"] = vote.Metadata
+		}
+		if validator, found := validatorByAccAddr[vote.Voter]; found {
+			voteInfo["validator"] = map[string]string{
+				"operatorAddress": validator.validator.OperatorAddress,
+				"moniker":         validator.validator.Description.Moniker,
+			}
+		}
+
+		votesInfo = append(votesInfo, voteInfo)
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"proposalId": proposalId,
+		"votes":      votesInfo,
+		"total":      total,
+		"pageNo":     pageNo,
+		"pageSize":   defaultPageSize,
+	}, nil
+}
+
+func (m *Backend) GetGovProposalDeposits(proposalId uint64, pageNo int) (berpctypes.GenericBackendResponse, error) {
+	if proposalId < 1 {
+		return nil, berpctypes.ErrBadRequest
+	}
+	if pageNo < 1 {
+		return nil, berpctypes.ErrBadPageNo
+	}
+
+	pagination := getDefaultPagination(pageNo)
+	pagination.CountTotal = true
+
+	resDeposits, err := m.queryClient.GovV1QueryClient.Deposits(m.ctx, &govv1types.QueryDepositsRequest{
+		ProposalId: proposalId,
+		Pagination: pagination,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get proposal deposits").Error())
 	}
 
-	proposals := make(map[uint64]any, 0)
-	for _, proposal := range resProposals.Proposals {
-		proposals[proposal.Id] = proposalToMap(proposal, m.clientCtx.Codec)
+	validatorByAccAddr := m.getValidatorsByAccountAddress()
+
+	depositsInfo := make([]berpctypes.GenericBackendResponse, 0, len(resDeposits.Deposits))
+	for _, deposit := range resDeposits.Deposits {
+		depositInfo := berpctypes.GenericBackendResponse{
+			"depositor": deposit.Depositor,
+			"amount":    berpcutils.CoinsToMap(deposit.Amount...),
+		}
+		if validator, found := validatorByAccAddr[deposit.Depositor]; found {
+			depositInfo["validator"] = map[string]string{
+				"operatorAddress": validator.validator.OperatorAddress,
+				"moniker":         validator.validator.Description.Moniker,
+			}
+		}
+
+		depositsInfo = append(depositsInfo, depositInfo)
+	}
+
+	var total int
+	if resDeposits.Pagination != nil {
+		total = int(resDeposits.Pagination.Total)
 	}
 
 	return berpctypes.GenericBackendResponse{
-		"proposals": proposals,
-		"pageNo":    pageNo,
-		"pageSize":  defaultPageSize,
+		"proposalId": proposalId,
+		"deposits":   depositsInfo,
+		"total":      total,
+		"pageNo":     pageNo,
+		"pageSize":   defaultPageSize,
 	}, nil
 }
 
+// parseVoteOption parses the vote option from the short form (yes, no, abstain, no_with_veto)
+// or the proto enum name (VOTE_OPTION_YES,...).
+func parseVoteOption(option string) (govv1types.VoteOption, bool) {
+	switch strings.ToLower(option) {
+	case "yes", "vote_option_yes":
+		return govv1types.OptionYes, true
+	case "no", "vote_option_no":
+		return govv1types.OptionNo, true
+	case "abstain", "vote_option_abstain":
+		return govv1types.OptionAbstain, true
+	case "no_with_veto", "nowithveto", "veto", "vote_option_no_with_veto":
+		return govv1types.OptionNoWithVeto, true
+	default:
+		return govv1types.OptionEmpty, false
+	}
+}
+
+// weightedVoteOptionsToResponse returns the option names (VOTE_OPTION_YES,...) along with the weights.
+func weightedVoteOptionsToResponse(weightedOptions govv1types.WeightedVoteOptions) []map[string]string {
+	options := make([]map[string]string, 0, len(weightedOptions))
+	for _, weightedOption := range weightedOptions {
+		options = append(options, map[string]string{
+			"option": weightedOption.Option.String(),
+			"weight": weightedOption.Weight,
+		})
+	}
+	return options
+}
+
+// isVotedFor returns true if the vote has the given option with positive weight (weighted vote).
+func isVotedFor(vote *govv1types.Vote, option govv1types.VoteOption) bool {
+	for _, weightedOption := range vote.Options {
+		if weightedOption.Option != option {
+			continue
+		}
+		weight, err := sdk.NewDecFromStr(weightedOption.Weight)
+		if err == nil && weight.IsPositive() {
+			return true
+		}
+	}
+	return false
+}
+
 func proposalToMap(proposal *govv1types.Proposal, codec codec.Codec) map[string]any {
 	proposalInfo := map[string]any{
 		"id":       proposal.Id,
@@ -72,6 +879,18 @@ func proposalToMap(proposal *govv1types.Proposal, codec codec.Codec) map[string]
 				}
 			}
 
+			if msg.TypeUrl == sdk.MsgTypeURL(&govv1types.MsgExecLegacyContent{}) {
+				content, contentType, err := extractLegacyContent(msg, codec)
+				if err != nil {
+					message["legacyContentError"] = err.Error()
+				} else {
+					proposalInfo["title"] = content.GetTitle()
+					proposalInfo["description"] = content.GetDescription()
+					proposalInfo["contentType"] = contentType
+					message["legacyContentType"] = contentType
+				}
+			}
+
 			messages = append(messages, message)
 		}
 		proposalInfo["messages"] = messages
@@ -103,3 +922,25 @@ func proposalToMap(proposal *govv1types.Proposal, codec codec.Codec) map[string]
 
 	return proposalInfo
 }
+
+// extractLegacyContent extracts the legacy proposal content and its type URL from the MsgExecLegacyContent.
+func extractLegacyContent(msg *codectypes.Any, codec codec.Codec) (content govv1beta1types.Content, contentType string, err error) {
+	var legacyContentMsg govv1types.MsgExecLegacyContent
+	if err = codec.Unmarshal(msg.Value, &legacyContentMsg); err != nil {
+		err = errors.Wrap(err, "failed to unmarshal MsgExecLegacyContent")
+		return
+	}
+
+	if legacyContentMsg.Content == nil {
+		err = errors.New("legacy content is empty")
+		return
+	}
+
+	if err = codec.UnpackAny(legacyContentMsg.Content, &content); err != nil {
+		err = errors.Wrap(err, "failed to unpack legacy content")
+		return
+	}
+
+	contentType = legacyContentMsg.Content.TypeUrl
+	return
+}
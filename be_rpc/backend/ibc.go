@@ -0,0 +1,943 @@
+package backend
+
+import (
+	"encoding/hex"
+	"fmt"
+	berpctypes "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/types"
+	berpcutils "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/utils"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"strings"
+	"time"
+)
+
+func (m *Backend) GetIbcChannels(pageNo int) (berpctypes.GenericBackendResponse, error) {
+	if pageNo < 1 {
+		return nil, berpctypes.ErrBadPageNo
+	}
+
+	pagination := getDefaultPagination(pageNo)
+	pagination.CountTotal = true
+
+	resChannels, err := m.queryClient.IbcChannelQueryClient.Channels(m.ctx, &channeltypes.QueryChannelsRequest{
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get IBC channels").Error())
+	}
+
+	resolver := newIbcCounterpartyResolver(m)
+
+	channelsInfo := make([]berpctypes.GenericBackendResponse, 0, len(resChannels.Channels))
+	for _, channel := range resChannels.Channels {
+		channelsInfo = append(channelsInfo, resolver.channelToResponse(*channel))
+	}
+
+	var total int
+	if resChannels.Pagination != nil {
+		total = int(resChannels.Pagination.Total)
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"channels": channelsInfo,
+		"total":    total,
+		"pageNo":   pageNo,
+		"pageSize": defaultPageSize,
+	}, nil
+}
+
+func (m *Backend) GetIbcChannel(port, channel string) (berpctypes.GenericBackendResponse, error) {
+	port = strings.TrimSpace(port)
+	channel = strings.TrimSpace(channel)
+	if port == "" || channel == "" {
+		return nil, berpctypes.ErrBadRequest
+	}
+
+	resChannel, err := m.queryClient.IbcChannelQueryClient.Channel(m.ctx, &channeltypes.QueryChannelRequest{
+		PortId:    port,
+		ChannelId: channel,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get IBC channel").Error())
+	}
+	if resChannel.Channel == nil {
+		return nil, status.Error(codes.NotFound, "channel could not be found")
+	}
+
+	resolver := newIbcCounterpartyResolver(m)
+
+	identifiedChannel := channeltypes.NewIdentifiedChannel(port, channel, *resChannel.Channel)
+	res := resolver.channelToResponse(identifiedChannel)
+
+	if len(identifiedChannel.ConnectionHops) > 0 {
+		if connection := resolver.getConnection(identifiedChannel.ConnectionHops[0]); connection != nil {
+			res["connection"] = resolver.connectionToResponse(*connection)
+		}
+	}
+
+	return res, nil
+}
+
+func (m *Backend) GetIbcConnections(pageNo int) (berpctypes.GenericBackendResponse, error) {
+	if pageNo < 1 {
+		return nil, berpctypes.ErrBadPageNo
+	}
+
+	pagination := getDefaultPagination(pageNo)
+	pagination.CountTotal = true
+
+	resConnections, err := m.queryClient.IbcConnectionQueryClient.Connections(m.ctx, &connectiontypes.QueryConnectionsRequest{
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get IBC connections").Error())
+	}
+
+	resolver := newIbcCounterpartyResolver(m)
+
+	connectionsInfo := make([]berpctypes.GenericBackendResponse, 0, len(resConnections.Connections))
+	for _, connection := range resConnections.Connections {
+		connectionsInfo = append(connectionsInfo, resolver.connectionToResponse(*connection))
+	}
+
+	var total int
+	if resConnections.Pagination != nil {
+		total = int(resConnections.Pagination.Total)
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"connections": connectionsInfo,
+		"total":       total,
+		"pageNo":      pageNo,
+		"pageSize":    defaultPageSize,
+	}, nil
+}
+
+func (m *Backend) GetIbcClients(pageNo int) (berpctypes.GenericBackendResponse, error) {
+	if pageNo < 1 {
+		return nil, berpctypes.ErrBadPageNo
+	}
+
+	pagination := getDefaultPagination(pageNo)
+	pagination.CountTotal = true
+
+	resClientStates, err := m.queryClient.IbcClientQueryClient.ClientStates(m.ctx, &ibctypes.QueryClientStatesRequest{
+		Pagination: pagination,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get IBC clients").Error())
+	}
+
+	clientsInfo := make([]berpctypes.GenericBackendResponse, 0, len(resClientStates.ClientStates))
+	for _, clientState := range resClientStates.ClientStates {
+		clientsInfo = append(clientsInfo, m.clientStateToResponse(clientState.ClientId, clientState.ClientState))
+	}
+
+	var total int
+	if resClientStates.Pagination != nil {
+		total = int(resClientStates.Pagination.Total)
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"clients":  clientsInfo,
+		"total":    total,
+		"pageNo":   pageNo,
+		"pageSize": defaultPageSize,
+	}, nil
+}
+
+// clientStateToResponse builds the response of an IBC client, includes the client status.
+// For Tendermint light clients, counterparty chain id, latest height and trusting period are included.
+func (m *Backend) clientStateToResponse(clientId string, clientStateAny *codectypes.Any) berpctypes.GenericBackendResponse {
+	res := berpctypes.GenericBackendResponse{
+		"clientId": clientId,
+	}
+
+	resClientStatus, err := m.queryClient.IbcClientQueryClient.ClientStatus(m.ctx, &ibctypes.QueryClientStatusRequest{
+		ClientId: clientId,
+	})
+	if err != nil {
+		m.GetLogger().Error("failed to get IBC client status", "client", clientId, "error", err)
+	} else {
+		res["status"] = strings.ToLower(resClientStatus.Status)
+	}
+
+	if clientStateAny == nil {
+		return res
+	}
+
+	res["type"] = clientStateAny.TypeUrl
+
+	var clientState ibcexported.ClientState
+	if err := m.clientCtx.Codec.UnpackAny(clientStateAny, &clientState); err != nil {
+		res["clientStateError"] = err.Error()
+		return res
+	}
+
+	res["clientType"] = clientState.ClientType()
+	res["latestHeight"] = clientState.GetLatestHeight().String()
+
+	if tmClientState, ok := clientState.(*ibctm.ClientState); ok {
+		res["counterpartyChainId"] = tmClientState.ChainId
+		res["trustingPeriodSeconds"] = int64(tmClientState.TrustingPeriod.Seconds())
+		res["unbondingPeriodSeconds"] = int64(tmClientState.UnbondingPeriod.Seconds())
+		res["frozen"] = !tmClientState.FrozenHeight.IsZero()
+	}
+
+	return res
+}
+
+// ibcCounterpartyResolver resolves and memorizes the connections and clients,
+// used to build the counterparty information of channels and connections within a single request.
+type ibcCounterpartyResolver struct {
+	m           *Backend
+	connections map[string]*connectiontypes.IdentifiedConnection
+	clients     map[string]berpctypes.GenericBackendResponse
+}
+
+func newIbcCounterpartyResolver(m *Backend) *ibcCounterpartyResolver {
+	return &ibcCounterpartyResolver{
+		m:           m,
+		connections: make(map[string]*connectiontypes.IdentifiedConnection),
+		clients:     make(map[string]berpctypes.GenericBackendResponse),
+	}
+}
+
+// getConnection returns the connection by id, nil if any error.
+func (r *ibcCounterpartyResolver) getConnection(connectionId string) *connectiontypes.IdentifiedConnection {
+	if connection, found := r.connections[connectionId]; found {
+		return connection
+	}
+
+	var connection *connectiontypes.IdentifiedConnection
+
+	resConnection, err := r.m.queryClient.IbcConnectionQueryClient.Connection(r.m.ctx, &connectiontypes.QueryConnectionRequest{
+		ConnectionId: connectionId,
+	})
+	if err != nil {
+		r.m.GetLogger().Error("failed to get IBC connection", "connection", connectionId, "error", err)
+	} else if resConnection.Connection != nil {
+		identifiedConnection := connectiontypes.NewIdentifiedConnection(connectionId, *resConnection.Connection)
+		connection = &identifiedConnection
+	}
+
+	r.connections[connectionId] = connection
+	return connection
+}
+
+// getClient returns the client information by id.
+func (r *ibcCounterpartyResolver) getClient(clientId string) berpctypes.GenericBackendResponse {
+	if client, found := r.clients[clientId]; found {
+		return client
+	}
+
+	var clientStateAny *codectypes.Any
+
+	resClientState, err := r.m.queryClient.IbcClientQueryClient.ClientState(r.m.ctx, &ibctypes.QueryClientStateRequest{
+		ClientId: clientId,
+	})
+	if err != nil {
+		r.m.GetLogger().Error("failed to get IBC client state", "client", clientId, "error", err)
+	} else {
+		clientStateAny = resClientState.ClientState
+	}
+
+	client := r.m.clientStateToResponse(clientId, clientStateAny)
+	r.clients[clientId] = client
+	return client
+}
+
+func (r *ibcCounterpartyResolver) channelToResponse(channel channeltypes.IdentifiedChannel) berpctypes.GenericBackendResponse {
+	res := berpctypes.GenericBackendResponse{
+		"port":           channel.PortId,
+		"channel":        channel.ChannelId,
+		"state":          channel.State.String(),
+		"ordering":       channel.Ordering.String(),
+		"version":        channel.Version,
+		"connectionHops": channel.ConnectionHops,
+		"counterparty": map[string]string{
+			"port":    channel.Counterparty.PortId,
+			"channel": channel.Counterparty.ChannelId,
+		},
+	}
+
+	if len(channel.ConnectionHops) < 1 {
+		return res
+	}
+
+	connection := r.getConnection(channel.ConnectionHops[0])
+	if connection == nil {
+		return res
+	}
+
+	res["counterparty"].(map[string]string)["connection"] = connection.Counterparty.ConnectionId
+
+	client := r.getClient(connection.ClientId)
+	res["client"] = client
+	if chainId, ok := client["counterpartyChainId"].(string); ok {
+		res["counterparty"].(map[string]string)["chainId"] = chainId
+	}
+
+	return res
+}
+
+func (r *ibcCounterpartyResolver) connectionToResponse(connection connectiontypes.IdentifiedConnection) berpctypes.GenericBackendResponse {
+	counterparty := map[string]string{
+		"client":     connection.Counterparty.ClientId,
+		"connection": connection.Counterparty.ConnectionId,
+	}
+
+	client := r.getClient(connection.ClientId)
+	if chainId, ok := client["counterpartyChainId"].(string); ok {
+		counterparty["chainId"] = chainId
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"connection":         connection.Id,
+		"state":              connection.State.String(),
+		"delayPeriodSeconds": connection.DelayPeriod / 1e9,
+		"client":             client,
+		"counterparty":       counterparty,
+	}
+}
+
+const (
+	ibcPacketStatusPending      = "pending"
+	ibcPacketStatusAcknowledged = "acknowledged"
+	ibcPacketStatusTimedOut     = "timed_out"
+	ibcPacketStatusReceived     = "received"
+	ibcPacketStatusUnknown      = "unknown"
+)
+
+const (
+	ibcPacketDirectionIncoming = "incoming"
+	ibcPacketDirectionOutgoing = "outgoing"
+)
+
+func (m *Backend) GetIbcPacket(srcPort, srcChannel string, sequence uint64, dstPort, dstChannel, direction string) (berpctypes.GenericBackendResponse, error) {
+	srcPort = strings.TrimSpace(srcPort)
+	srcChannel = strings.TrimSpace(srcChannel)
+	dstPort = strings.TrimSpace(dstPort)
+	dstChannel = strings.TrimSpace(dstChannel)
+	direction = strings.TrimSpace(direction)
+	if sequence < 1 {
+		return nil, berpctypes.ErrBadRequest
+	}
+	// identifiers are put into the tx search query, so they must be validated
+	if err := validateIbcPortAndChannel(srcPort, srcChannel); err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "invalid source").Error())
+	}
+	if (dstPort == "") != (dstChannel == "") {
+		return nil, status.Error(codes.InvalidArgument, "destination port and channel must be provided together")
+	}
+	if dstPort != "" {
+		if err := validateIbcPortAndChannel(dstPort, dstChannel); err != nil {
+			return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "invalid destination").Error())
+		}
+	}
+	switch direction {
+	case "", ibcPacketDirectionIncoming, ibcPacketDirectionOutgoing:
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("direction must be either %s or %s", ibcPacketDirectionIncoming, ibcPacketDirectionOutgoing))
+	}
+
+	res := berpctypes.GenericBackendResponse{
+		"sourcePort":    srcPort,
+		"sourceChannel": srcChannel,
+		"sequence":      sequence,
+	}
+
+	// outgoing packet, sent from this chain
+	var sendTx *coretypes.ResultTx
+	var sendEvent map[string]string
+	if direction != ibcPacketDirectionIncoming {
+		var err error
+		sendTx, sendEvent, err = m.findIbcPacketTx(channeltypes.EventTypeSendPacket, srcPort, srcChannel, sequence, dstPort, dstChannel)
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to search send packet transaction").Error())
+		}
+	}
+
+	// incoming packet, sent from counterparty chain and received on this chain
+	var recvTx *coretypes.ResultTx
+	var recvEvent map[string]string
+	if direction != ibcPacketDirectionOutgoing {
+		var err error
+		recvTx, recvEvent, err = m.findIbcRecvPacketTx(srcPort, srcChannel, sequence, dstPort, dstChannel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// sequences start from 1 on every channel and the same channel identifiers usually exist on both chains,
+	// so both an outgoing and an incoming packet can match the given source port, channel and sequence
+	if sendTx != nil && recvTx != nil {
+		return nil, status.Error(codes.InvalidArgument, "both an outgoing and an incoming packet match the given source port, channel and sequence, direction must be provided")
+	}
+
+	if sendTx != nil {
+		res["incoming"] = false
+		res["destinationPort"] = sendEvent[channeltypes.AttributeKeyDstPort]
+		res["destinationChannel"] = sendEvent[channeltypes.AttributeKeyDstChannel]
+		res["sendTx"] = ibcPacketTxToResponse(sendTx)
+		addIbcPacketDataIntoResponse(sendEvent, res)
+
+		resCommitment, err := m.queryClient.IbcChannelQueryClient.PacketCommitment(m.ctx, &channeltypes.QueryPacketCommitmentRequest{
+			PortId:    srcPort,
+			ChannelId: srcChannel,
+			Sequence:  sequence,
+		})
+		commitmentExists := err == nil && len(resCommitment.Commitment) > 0
+		res["commitmentExists"] = commitmentExists
+
+		ackTx, _, err := m.findIbcPacketTx(channeltypes.EventTypeAcknowledgePacket, srcPort, srcChannel, sequence, "", "")
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to search acknowledge packet transaction").Error())
+		}
+
+		timeoutTx, _, err := m.findIbcPacketTx(channeltypes.EventTypeTimeoutPacket, srcPort, srcChannel, sequence, "", "")
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to search timeout packet transaction").Error())
+		}
+
+		packetStatus := ibcPacketStatusUnknown
+		if ackTx != nil {
+			packetStatus = ibcPacketStatusAcknowledged
+			res["acknowledgementTx"] = ibcPacketTxToResponse(ackTx)
+
+			if ackBz := m.getAcknowledgementFromTx(ackTx, srcPort, srcChannel, sequence); len(ackBz) > 0 {
+				res["acknowledgement"] = decodeIbcAcknowledgement(ackBz)
+			}
+		} else if timeoutTx != nil {
+			packetStatus = ibcPacketStatusTimedOut
+			res["timeoutTx"] = ibcPacketTxToResponse(timeoutTx)
+		} else if commitmentExists {
+			packetStatus = ibcPacketStatusPending
+		}
+		res["status"] = packetStatus
+
+		return res, nil
+	}
+
+	if recvTx == nil {
+		return nil, status.Error(codes.NotFound, "packet could not be found")
+	}
+
+	dstPort = recvEvent[channeltypes.AttributeKeyDstPort]
+	dstChannel = recvEvent[channeltypes.AttributeKeyDstChannel]
+
+	res["incoming"] = true
+	res["destinationPort"] = dstPort
+	res["destinationChannel"] = dstChannel
+	res["recvTx"] = ibcPacketTxToResponse(recvTx)
+	addIbcPacketDataIntoResponse(recvEvent, res)
+
+	resReceipt, err := m.queryClient.IbcChannelQueryClient.PacketReceipt(m.ctx, &channeltypes.QueryPacketReceiptRequest{
+		PortId:    dstPort,
+		ChannelId: dstChannel,
+		Sequence:  sequence,
+	})
+	res["receiptExists"] = err == nil && resReceipt.Received
+
+	resAck, err := m.queryClient.IbcChannelQueryClient.PacketAcknowledgement(m.ctx, &channeltypes.QueryPacketAcknowledgementRequest{
+		PortId:    dstPort,
+		ChannelId: dstChannel,
+		Sequence:  sequence,
+	})
+	ackExists := err == nil && len(resAck.Acknowledgement) > 0
+	res["acknowledgementExists"] = ackExists
+
+	// the acknowledgement is written within the receive transaction,
+	// unless it is written asynchronously by a later transaction (eg: packet forwarding, fee middleware)
+	ackBz := getWriteAcknowledgementFromTx(recvTx, srcPort, srcChannel, sequence)
+	if len(ackBz) == 0 && ackExists {
+		writeAckTx, _, err := m.findIbcPacketTx(channeltypes.EventTypeWriteAck, srcPort, srcChannel, sequence, dstPort, dstChannel)
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to search write acknowledgement transaction").Error())
+		}
+
+		if writeAckTx != nil {
+			res["acknowledgementTx"] = ibcPacketTxToResponse(writeAckTx)
+			ackBz = getWriteAcknowledgementFromTx(writeAckTx, srcPort, srcChannel, sequence)
+		}
+	}
+
+	packetStatus := ibcPacketStatusReceived
+	if ackExists || len(ackBz) > 0 {
+		packetStatus = ibcPacketStatusAcknowledged
+	}
+	if len(ackBz) > 0 {
+		res["acknowledgement"] = decodeIbcAcknowledgement(ackBz)
+	}
+	res["status"] = packetStatus
+
+	return res, nil
+}
+
+// findIbcRecvPacketTx finds the transaction receiving the packet on this chain.
+// When destination port and channel are not provided, the channels of this chain
+// connected to the given source port and channel are searched.
+func (m *Backend) findIbcRecvPacketTx(srcPort, srcChannel string, sequence uint64, dstPort, dstChannel string) (*coretypes.ResultTx, map[string]string, error) {
+	if dstPort != "" {
+		recvTx, recvEvent, err := m.findIbcPacketTx(channeltypes.EventTypeRecvPacket, srcPort, srcChannel, sequence, dstPort, dstChannel)
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, errors.Wrap(err, "failed to search receive packet transaction").Error())
+		}
+		return recvTx, recvEvent, nil
+	}
+
+	// different counterparties can use the same source port and channel, so the local channel must be determined
+	localChannels, err := m.getIbcChannelsByCounterparty(srcPort, srcChannel)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get IBC channels").Error())
+	}
+
+	var recvTx *coretypes.ResultTx
+	var recvEvent map[string]string
+	for _, localChannel := range localChannels {
+		resultTx, event, err := m.findIbcPacketTx(channeltypes.EventTypeRecvPacket, srcPort, srcChannel, sequence, localChannel.PortId, localChannel.ChannelId)
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, errors.Wrap(err, "failed to search receive packet transaction").Error())
+		}
+		if resultTx == nil {
+			continue
+		}
+		if recvTx != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "packet was received on multiple channels connected to the given source port and channel, destination port and channel must be provided")
+		}
+		recvTx = resultTx
+		recvEvent = event
+	}
+
+	return recvTx, recvEvent, nil
+}
+
+// findIbcPacketTx finds the first transaction from the tx index, which emitted the IBC packet event
+// matching the given source port, source channel and sequence, also the destination port and channel if provided.
+// Port and channel identifiers must be validated before calling this.
+// Returns nil if not found, otherwise the transaction and the requested attributes of the matched event.
+func (m *Backend) findIbcPacketTx(eventType, srcPort, srcChannel string, sequence uint64, dstPort, dstChannel string) (*coretypes.ResultTx, map[string]string, error) {
+	txSearchQuery := fmt.Sprintf(
+		"%s.%s='%s' AND %s.%s='%s' AND %s.%s='%d'",
+		eventType, channeltypes.AttributeKeySrcPort, srcPort,
+		eventType, channeltypes.AttributeKeySrcChannel, srcChannel,
+		eventType, channeltypes.AttributeKeySequence, sequence,
+	)
+	filterByDestination := dstPort != "" && dstChannel != ""
+	if filterByDestination {
+		txSearchQuery += fmt.Sprintf(
+			" AND %s.%s='%s' AND %s.%s='%s'",
+			eventType, channeltypes.AttributeKeyDstPort, dstPort,
+			eventType, channeltypes.AttributeKeyDstChannel, dstChannel,
+		)
+	}
+
+	var page = 1
+	var perPage = 1
+	resTxSearch, err := m.clientCtx.Client.TxSearch(m.ctx, txSearchQuery, false, &page, &perPage, "asc")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(resTxSearch.Txs) < 1 {
+		return nil, nil, nil
+	}
+
+	resultTx := resTxSearch.Txs[0]
+
+	keys := []string{
+		channeltypes.AttributeKeySrcPort,
+		channeltypes.AttributeKeySrcChannel,
+		channeltypes.AttributeKeySequence,
+		channeltypes.AttributeKeyDstPort,
+		channeltypes.AttributeKeyDstChannel,
+	}
+	for _, event := range resultTx.TxResult.Events {
+		ok, kv := berpcutils.IsEventTypeWithAllAttributes(event, eventType, keys...)
+		if !ok || kv[channeltypes.AttributeKeySrcPort] != srcPort || kv[channeltypes.AttributeKeySrcChannel] != srcChannel || kv[channeltypes.AttributeKeySequence] != fmt.Sprintf("%d", sequence) {
+			continue
+		}
+		if filterByDestination && (kv[channeltypes.AttributeKeyDstPort] != dstPort || kv[channeltypes.AttributeKeyDstChannel] != dstChannel) {
+			continue
+		}
+
+		// include packet data
+		for _, attribute := range event.Attributes {
+			switch string(attribute.Key) {
+			case channeltypes.AttributeKeyDataHex, channeltypes.AttributeKeyData:
+				kv[string(attribute.Key)] = string(attribute.Value)
+			}
+		}
+
+		return resultTx, kv, nil
+	}
+
+	// the tx search query matched attributes of different events
+	return nil, nil, nil
+}
+
+// validateIbcPortAndChannel validates the port and channel identifiers, following ICS-24.
+func validateIbcPortAndChannel(port, channel string) error {
+	if err := host.PortIdentifierValidator(port); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(channel)
+}
+
+// getIbcChannelsByCounterparty returns the channels of this chain, which are connected to the given counterparty port and channel.
+func (m *Backend) getIbcChannelsByCounterparty(counterpartyPort, counterpartyChannel string) ([]*channeltypes.IdentifiedChannel, error) {
+	channels := make([]*channeltypes.IdentifiedChannel, 0)
+
+	var nextKey []byte
+	for {
+		resChannels, err := m.queryClient.IbcChannelQueryClient.Channels(m.ctx, &channeltypes.QueryChannelsRequest{
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: 1000,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range resChannels.Channels {
+			if channel.Counterparty.PortId == counterpartyPort && channel.Counterparty.ChannelId == counterpartyChannel {
+				channels = append(channels, channel)
+			}
+		}
+
+		if resChannels.Pagination == nil || len(resChannels.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resChannels.Pagination.NextKey
+	}
+
+	return channels, nil
+}
+
+// getWriteAcknowledgementFromTx returns the acknowledgement bytes written on this chain for the received packet.
+func getWriteAcknowledgementFromTx(resultTx *coretypes.ResultTx, srcPort, srcChannel string, sequence uint64) []byte {
+	for _, event := range resultTx.TxResult.Events {
+		ok, kv := berpcutils.IsEventTypeWithAllAttributes(event, channeltypes.EventTypeWriteAck, channeltypes.AttributeKeySequence, channeltypes.AttributeKeySrcPort, channeltypes.AttributeKeySrcChannel)
+		if !ok || kv[channeltypes.AttributeKeySequence] != fmt.Sprintf("%d", sequence) || kv[channeltypes.AttributeKeySrcPort] != srcPort || kv[channeltypes.AttributeKeySrcChannel] != srcChannel {
+			continue
+		}
+
+		return getIbcEventBytes(event, channeltypes.AttributeKeyAckHex, channeltypes.AttributeKeyAck)
+	}
+
+	return nil
+}
+
+// getAcknowledgementFromTx returns the acknowledgement bytes relayed by the MsgAcknowledgement of the packet.
+func (m *Backend) getAcknowledgementFromTx(resultTx *coretypes.ResultTx, srcPort, srcChannel string, sequence uint64) []byte {
+	resTx, err := m.queryClient.GetTx(m.ctx, &tx.GetTxRequest{
+		Hash: fmt.Sprintf("%X", resultTx.Hash),
+	})
+	if err != nil || resTx.Tx == nil || resTx.Tx.Body == nil {
+		m.GetLogger().Error("failed to get acknowledgement tx", "hash", resultTx.Hash.String(), "error", err)
+		return nil
+	}
+
+	for _, msg := range resTx.Tx.Body.Messages {
+		var cosmosMsg sdk.Msg
+		if err := m.clientCtx.Codec.UnpackAny(msg, &cosmosMsg); err != nil {
+			continue
+		}
+
+		msgAck, ok := cosmosMsg.(*channeltypes.MsgAcknowledgement)
+		if !ok {
+			continue
+		}
+
+		packet := msgAck.Packet
+		if packet.SourcePort == srcPort && packet.SourceChannel == srcChannel && packet.Sequence == sequence {
+			return msgAck.Acknowledgement
+		}
+	}
+
+	return nil
+}
+
+func ibcPacketTxToResponse(resultTx *coretypes.ResultTx) map[string]any {
+	return map[string]any{
+		"hash":   strings.ToUpper(hex.EncodeToString(resultTx.Hash)),
+		"height": resultTx.Height,
+	}
+}
+
+// getIbcEventBytes returns the bytes value of the event attribute, prefer the hex encoded attribute.
+func getIbcEventBytes(event abci.Event, hexAttributeKey, attributeKey string) []byte {
+	var raw []byte
+	for _, attribute := range event.Attributes {
+		switch string(attribute.Key) {
+		case hexAttributeKey:
+			if bz, err := hex.DecodeString(string(attribute.Value)); err == nil {
+				return bz
+			}
+		case attributeKey:
+			raw = attribute.Value
+		}
+	}
+	return raw
+}
+
+// addIbcPacketDataIntoResponse decodes the packet data from the packet event attributes,
+// ICS-20 fungible token packet data is decoded, otherwise the raw data is included.
+func addIbcPacketDataIntoResponse(packetEventAttributes map[string]string, res berpctypes.GenericBackendResponse) {
+	var packetData []byte
+	if dataHex, found := packetEventAttributes[channeltypes.AttributeKeyDataHex]; found {
+		if bz, err := hex.DecodeString(dataHex); err == nil {
+			packetData = bz
+		}
+	}
+	if len(packetData) == 0 {
+		packetData = []byte(packetEventAttributes[channeltypes.AttributeKeyData])
+	}
+	if len(packetData) == 0 {
+		return
+	}
+
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packetData, &data); err == nil && len(data.Denom) > 0 {
+		fungibleTokenPacketData := map[string]string{
+			"denom":    data.Denom,
+			"amount":   data.Amount,
+			"sender":   data.Sender,
+			"receiver": data.Receiver,
+		}
+		if data.Memo != "" {
+			fungibleTokenPacketData["memo"] = data.Memo
+		}
+		res["fungibleTokenPacketData"] = fungibleTokenPacketData
+		if ibcMemo, ok := berpcutils.DecodeIbcMemo(data.Memo); ok {
+			res["ibcMemo"] = ibcMemoToResponse(ibcMemo)
+		}
+		return
+	}
+
+	res["packetDataRaw"] = string(packetData)
+}
+
+// decodeIbcAcknowledgement decodes the acknowledgement into success/error result.
+func decodeIbcAcknowledgement(ackBz []byte) map[string]any {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack); err != nil {
+		return map[string]any{
+			"raw": string(ackBz),
+		}
+	}
+
+	res := map[string]any{
+		"success": ack.Success(),
+	}
+	if ack.Success() {
+		res["result"] = string(ack.GetResult())
+	} else {
+		res["error"] = ack.GetError()
+	}
+	return res
+}
+
+// ibcRelayersLookBackTxs is the number of recent relay transactions, of each kind, used to find relayers of a channel.
+const ibcRelayersLookBackTxs = 50
+
+// maxIbcPendingSequences is the maximum number of pending sequences to be returned, the oldest ones first.
+const maxIbcPendingSequences = 100
+
+func (m *Backend) GetIbcChannelBacklog(port, channel string) (berpctypes.GenericBackendResponse, error) {
+	port = strings.TrimSpace(port)
+	channel = strings.TrimSpace(channel)
+	// identifiers are put into the tx search query, so they must be validated
+	if err := validateIbcPortAndChannel(port, channel); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resChannel, err := m.queryClient.IbcChannelQueryClient.Channel(m.ctx, &channeltypes.QueryChannelRequest{
+		PortId:    port,
+		ChannelId: channel,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "channel could not be found")
+		}
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get IBC channel").Error())
+	}
+	if resChannel.Channel == nil {
+		return nil, status.Error(codes.NotFound, "channel could not be found")
+	}
+
+	// packets sent from this chain which are neither acknowledged nor timed out yet.
+	// Whether the counterparty received the packets can not be determined using the state of this chain alone.
+	pendingSequences := make([]uint64, 0)
+	var nextKey []byte
+	for {
+		resCommitments, err := m.queryClient.IbcChannelQueryClient.PacketCommitments(m.ctx, &channeltypes.QueryPacketCommitmentsRequest{
+			PortId:    port,
+			ChannelId: channel,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: 1000,
+			},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get packet commitments").Error())
+		}
+
+		for _, commitment := range resCommitments.Commitments {
+			pendingSequences = append(pendingSequences, commitment.Sequence)
+		}
+
+		if resCommitments.Pagination == nil || len(resCommitments.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resCommitments.Pagination.NextKey
+	}
+
+	sort.Slice(pendingSequences, func(i, j int) bool {
+		return pendingSequences[i] < pendingSequences[j]
+	})
+
+	// a stuck channel can hold a lot of pending packets, so only the oldest ones are returned
+	returnPendingSequences := pendingSequences
+	if len(returnPendingSequences) > maxIbcPendingSequences {
+		returnPendingSequences = returnPendingSequences[:maxIbcPendingSequences]
+	}
+
+	res := berpctypes.GenericBackendResponse{
+		"port":                      port,
+		"channel":                   channel,
+		"pendingPacketsCount":       len(pendingSequences),
+		"pendingSequences":          returnPendingSequences,
+		"pendingSequencesTruncated": len(pendingSequences) > len(returnPendingSequences),
+	}
+
+	if len(pendingSequences) > 0 {
+		oldestSequence := pendingSequences[0]
+		oldestPendingPacket := map[string]any{
+			"sequence": oldestSequence,
+		}
+
+		sendTx, _, err := m.findIbcPacketTx(channeltypes.EventTypeSendPacket, port, channel, oldestSequence, "", "")
+		if err != nil {
+			m.GetLogger().Error("failed to search send packet transaction", "sequence", oldestSequence, "error", err)
+		} else if sendTx != nil {
+			oldestPendingPacket["sendTx"] = ibcPacketTxToResponse(sendTx)
+
+			sentTimeEpochUTC, err := m.getBlockTimeEpochUTC(sendTx.Height)
+			if err != nil {
+				m.GetLogger().Error("failed to get block time", "height", sendTx.Height, "error", err)
+			} else {
+				oldestPendingPacket["sentTimeEpochUTC"] = sentTimeEpochUTC
+				oldestPendingPacket["ageSeconds"] = time.Now().UTC().Unix() - sentTimeEpochUTC
+			}
+		}
+
+		res["oldestPendingPacket"] = oldestPendingPacket
+	}
+
+	relayers, err := m.getRecentIbcRelayers(port, channel)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get recent relayers").Error())
+	}
+	res["recentRelayers"] = relayers
+
+	return res, nil
+}
+
+// getRecentIbcRelayers returns the signers of recent MsgRecvPacket and MsgAcknowledgement of the channel,
+// with number of relayed packets and the latest relayed height.
+func (m *Backend) getRecentIbcRelayers(port, channel string) (map[string]any, error) {
+	type relayerInfo struct {
+		recvPacketCount      int
+		acknowledgementCount int
+		latestHeight         int64
+	}
+	relayers := make(map[string]*relayerInfo)
+
+	getRelayer := func(signer string) *relayerInfo {
+		relayer, found := relayers[signer]
+		if !found {
+			relayer = &relayerInfo{}
+			relayers[signer] = relayer
+		}
+		return relayer
+	}
+
+	txSearchQueries := []string{
+		fmt.Sprintf("%s.%s='%s' AND %s.%s='%s'",
+			channeltypes.EventTypeRecvPacket, channeltypes.AttributeKeyDstPort, port,
+			channeltypes.EventTypeRecvPacket, channeltypes.AttributeKeyDstChannel, channel,
+		),
+		fmt.Sprintf("%s.%s='%s' AND %s.%s='%s'",
+			channeltypes.EventTypeAcknowledgePacket, channeltypes.AttributeKeySrcPort, port,
+			channeltypes.EventTypeAcknowledgePacket, channeltypes.AttributeKeySrcChannel, channel,
+		),
+	}
+
+	txDecoder := m.clientCtx.TxConfig.TxDecoder()
+
+	for _, txSearchQuery := range txSearchQueries {
+		var page = 1
+		var perPage = ibcRelayersLookBackTxs
+		resTxSearch, err := m.clientCtx.Client.TxSearch(m.ctx, txSearchQuery, false, &page, &perPage, "desc")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resultTx := range resTxSearch.Txs {
+			decodedTx, err := txDecoder(resultTx.Tx)
+			if err != nil {
+				m.GetLogger().Error("failed to decode relay transaction", "hash", resultTx.Hash.String(), "error", err)
+				continue
+			}
+
+			for _, msg := range decodedTx.GetMsgs() {
+				switch relayMsg := msg.(type) {
+				case *channeltypes.MsgRecvPacket:
+					if relayMsg.Packet.DestinationPort != port || relayMsg.Packet.DestinationChannel != channel {
+						continue
+					}
+					relayer := getRelayer(relayMsg.Signer)
+					relayer.recvPacketCount++
+					if resultTx.Height > relayer.latestHeight {
+						relayer.latestHeight = resultTx.Height
+					}
+				case *channeltypes.MsgAcknowledgement:
+					if relayMsg.Packet.SourcePort != port || relayMsg.Packet.SourceChannel != channel {
+						continue
+					}
+					relayer := getRelayer(relayMsg.Signer)
+					relayer.acknowledgementCount++
+					if resultTx.Height > relayer.latestHeight {
+						relayer.latestHeight = resultTx.Height
+					}
+				}
+			}
+		}
+	}
+
+	res := make(map[string]any)
+	for signer, relayer := range relayers {
+		res[signer] = map[string]any{
+			"recvPacketCount":      relayer.recvPacketCount,
+			"acknowledgementCount": relayer.acknowledgementCount,
+			"latestHeight":         relayer.latestHeight,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,167 @@
+package backend
+
+import (
+	"cosmossdk.io/errors"
+	"encoding/hex"
+	"fmt"
+	berpctypes "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/types"
+	berpcutils "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	defaultPendingTransactionsLimit = 30
+	// maxPendingTransactionsLimit is the maximum number of unconfirmed txs can be returned by Tendermint RPC at once.
+	maxPendingTransactionsLimit = 100
+)
+
+const (
+	pendingTxStatusPending  = "pending"
+	pendingTxStatusIncluded = "included"
+	// pendingTxStatusUnknown is used when the tx is neither found in the scanned part of the mempool nor in blocks,
+	// while the mempool is larger than the part can be scanned, so the tx might still be pending.
+	pendingTxStatusUnknown = "unknown"
+)
+
+func (m *Backend) GetPendingTransactions(limit int) (berpctypes.GenericBackendResponse, error) {
+	if limit == 0 {
+		limit = defaultPendingTransactionsLimit
+	}
+	if limit < 1 || limit > maxPendingTransactionsLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("limit must be between 1 and %d", maxPendingTransactionsLimit))
+	}
+
+	resUnconfirmedTxs, err := m.clientCtx.Client.UnconfirmedTxs(m.ctx, &limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get unconfirmed txs").Error())
+	}
+
+	txs := make([]map[string]any, 0, len(resUnconfirmedTxs.Txs))
+	for _, txBz := range resUnconfirmedTxs.Txs {
+		txs = append(txs, m.pendingTxToResponse(txBz))
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"txs":        txs,
+		"count":      resUnconfirmedTxs.Count,
+		"total":      resUnconfirmedTxs.Total,
+		"totalBytes": resUnconfirmedTxs.TotalBytes,
+	}, nil
+}
+
+func (m *Backend) GetPendingTransaction(hashStr string) (berpctypes.GenericBackendResponse, error) {
+	hashStr = strings.TrimSpace(hashStr)
+	if !patternTxHash.MatchString(hashStr) {
+		return nil, berpctypes.ErrBadRequest
+	}
+
+	hash := berpcutils.NormalizeTransactionHash(hashStr, true)[2:]
+	hashBz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, berpctypes.ErrBadRequest
+	}
+
+	limit := maxPendingTransactionsLimit
+	resUnconfirmedTxs, err := m.clientCtx.Client.UnconfirmedTxs(m.ctx, &limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get unconfirmed txs").Error())
+	}
+
+	for _, txBz := range resUnconfirmedTxs.Txs {
+		if !strings.EqualFold(fmt.Sprintf("%X", txBz.Hash()), hash) {
+			continue
+		}
+
+		res := m.pendingTxToResponse(txBz)
+		res["status"] = pendingTxStatusPending
+		res["mempoolSize"] = resUnconfirmedTxs.Total
+		return res, nil
+	}
+
+	// not in the mempool, maybe already included in a block
+	resTx, err := m.clientCtx.Client.Tx(m.ctx, hashBz, false)
+	if err != nil {
+		if !strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get tx").Error())
+		}
+
+		// Tendermint RPC does not support paging through the mempool, so only the first part can be scanned
+		if resUnconfirmedTxs.Total > len(resUnconfirmedTxs.Txs) {
+			return berpctypes.GenericBackendResponse{
+				"hash":         strings.ToUpper(hash),
+				"status":       pendingTxStatusUnknown,
+				"mempoolSize":  resUnconfirmedTxs.Total,
+				"scannedCount": len(resUnconfirmedTxs.Txs),
+				"reason":       "transaction not found in the scanned part of mempool nor in blocks, it might still be pending",
+			}, nil
+		}
+
+		return nil, status.Error(codes.NotFound, "transaction not found in mempool nor in blocks")
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"hash":    strings.ToUpper(hash),
+		"status":  pendingTxStatusIncluded,
+		"height":  resTx.Height,
+		"code":    resTx.TxResult.Code,
+		"success": resTx.TxResult.Code == 0,
+	}, nil
+}
+
+// pendingTxToResponse decodes the unconfirmed tx and builds the response.
+// Decode error is returned as part of the response rather than failing the whole request.
+func (m *Backend) pendingTxToResponse(tmTx tmtypes.Tx) map[string]any {
+	res := map[string]any{
+		"hash": fmt.Sprintf("%X", tmTx.Hash()),
+		"size": len(tmTx),
+	}
+
+	decodedTx, err := m.clientCtx.TxConfig.TxDecoder()(tmTx)
+	if err != nil {
+		res["decodeError"] = err.Error()
+		return res
+	}
+
+	messagesType := make([]string, 0)
+	for _, msg := range decodedTx.GetMsgs() {
+		messagesType = append(messagesType, sdk.MsgTypeURL(msg))
+	}
+	res["messagesType"] = messagesType
+
+	if sigTx, ok := decodedTx.(authsigning.SigVerifiableTx); ok {
+		func() {
+			defer func() {
+				_ = recover() // omit any error
+			}()
+
+			signers := make([]string, 0)
+			for _, signer := range sigTx.GetSigners() {
+				signers = append(signers, signer.String())
+			}
+			res["signers"] = signers
+		}()
+	}
+
+	if feeTx, ok := decodedTx.(sdk.FeeTx); ok {
+		res["fee"] = berpcutils.CoinsToMap(feeTx.GetFee()...)
+		res["gasLimit"] = feeTx.GetGas()
+
+		if feePayer := feeTx.FeePayer(); len(feePayer) > 0 {
+			res["feePayer"] = feePayer.String()
+		}
+		if feeGranter := feeTx.FeeGranter(); len(feeGranter) > 0 {
+			res["feeGranter"] = feeGranter.String()
+		}
+	}
+
+	if memoTx, ok := decodedTx.(sdk.TxWithMemo); ok && len(memoTx.GetMemo()) > 0 {
+		res["memo"] = memoTx.GetMemo()
+	}
+
+	return res
+}
@@ -1,13 +1,25 @@
 package backend
 
 import (
+	"bytes"
+	"fmt"
 	berpctypes "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/types"
+	berpcutils "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/pkg/errors"
+	tmmath "github.com/tendermint/tendermint/libs/math"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"math"
 	"sort"
 	"strings"
 )
@@ -79,31 +91,270 @@ func (m *Backend) GetStakingInfo(delegatorAddr string) (berpctypes.GenericBacken
 	return res, nil
 }
 
-func (m *Backend) GetValidators() (berpctypes.GenericBackendResponse, error) {
+// delegatorQueryPageSize is the page size used to fetch all records related to a delegator from staking module.
+const delegatorQueryPageSize = 200
+
+func (m *Backend) GetDelegatorStakingDetails(delegatorAddr string) (berpctypes.GenericBackendResponse, error) {
+	delegatorAddr = berpcutils.NormalizeAddress(delegatorAddr)
+	unsafeDelegatorAddr := m.bech32Cfg.FromAnyToBech32AccountAddrUnsafe(delegatorAddr)
+	if !m.bech32Cfg.IsAccountAddr(unsafeDelegatorAddr) {
+		return nil, berpctypes.ErrBadAddress
+	}
+
+	stakingParams, err := m.queryClient.StakingQueryClient.Params(m.ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking params").Error())
+	}
+	bondDenom := stakingParams.Params.BondDenom
+
+	// page through all records, rewards are returned for every delegation so the records must be complete
+	delegations := make([]stakingtypes.DelegationResponse, 0)
+	var nextKey []byte
+	for {
+		resDd, err := m.queryClient.StakingQueryClient.DelegatorDelegations(m.ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: unsafeDelegatorAddr,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: delegatorQueryPageSize,
+			},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get delegator delegations").Error())
+		}
+
+		delegations = append(delegations, resDd.DelegationResponses...)
+
+		if resDd.Pagination == nil || len(resDd.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resDd.Pagination.NextKey
+	}
+
+	unbondingDelegations := make([]stakingtypes.UnbondingDelegation, 0)
+	nextKey = nil
+	for {
+		resUbd, err := m.queryClient.StakingQueryClient.DelegatorUnbondingDelegations(m.ctx, &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+			DelegatorAddr: unsafeDelegatorAddr,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: delegatorQueryPageSize,
+			},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get delegator unbonding delegations").Error())
+		}
+
+		unbondingDelegations = append(unbondingDelegations, resUbd.UnbondingResponses...)
+
+		if resUbd.Pagination == nil || len(resUbd.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resUbd.Pagination.NextKey
+	}
+
+	redelegations := make([]stakingtypes.RedelegationResponse, 0)
+	nextKey = nil
+	for {
+		resRed, err := m.queryClient.StakingQueryClient.Redelegations(m.ctx, &stakingtypes.QueryRedelegationsRequest{
+			DelegatorAddr: unsafeDelegatorAddr,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: delegatorQueryPageSize,
+			},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get delegator re-delegations").Error())
+		}
+
+		redelegations = append(redelegations, resRed.RedelegationResponses...)
+
+		if resRed.Pagination == nil || len(resRed.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resRed.Pagination.NextKey
+	}
+
+	resDist, err := m.queryClient.DistributionQueryClient.DelegationTotalRewards(m.ctx, &disttypes.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: unsafeDelegatorAddr,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get delegation total rewards").Error())
+	}
+
+	resWithdrawAddr, err := m.queryClient.DistributionQueryClient.DelegatorWithdrawAddress(m.ctx, &disttypes.QueryDelegatorWithdrawAddressRequest{
+		DelegatorAddress: unsafeDelegatorAddr,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get delegator withdraw address").Error())
+	}
+
+	monikerByValAddr := m.getValidatorMonikersByOperatorAddress()
+
+	rewardsByValAddr := make(map[string]sdk.DecCoins)
+	for _, reward := range resDist.Rewards {
+		rewardsByValAddr[reward.ValidatorAddress] = reward.Reward
+	}
+
+	delegationsInfo := make([]berpctypes.GenericBackendResponse, 0)
+	for _, delegation := range delegations {
+		validatorAddress := delegation.Delegation.ValidatorAddress
+		delegationsInfo = append(delegationsInfo, berpctypes.GenericBackendResponse{
+			"validator": validatorAddress,
+			"moniker":   monikerByValAddr[validatorAddress],
+			"shares":    delegation.Delegation.Shares.String(),
+			"amount":    berpcutils.CoinsToMap(delegation.Balance),
+			"rewards":   berpcutils.CoinsToMap(truncateDecCoins(rewardsByValAddr[validatorAddress])...),
+		})
+	}
+
+	unbondingDelegationsInfo := make([]berpctypes.GenericBackendResponse, 0)
+	for _, unbondingDelegation := range unbondingDelegations {
+		unbondingDelegationsInfo = append(unbondingDelegationsInfo, berpctypes.GenericBackendResponse{
+			"validator": unbondingDelegation.ValidatorAddress,
+			"moniker":   monikerByValAddr[unbondingDelegation.ValidatorAddress],
+			"entries":   unbondingEntriesToResponse(unbondingDelegation.Entries, bondDenom),
+		})
+	}
+
+	redelegationsInfo := make([]berpctypes.GenericBackendResponse, 0)
+	for _, redelegation := range redelegations {
+		redelegationInfo := redelegationToResponse(redelegation, bondDenom)
+		redelegationInfo["monikerFrom"] = monikerByValAddr[redelegation.Redelegation.ValidatorSrcAddress]
+		redelegationInfo["monikerTo"] = monikerByValAddr[redelegation.Redelegation.ValidatorDstAddress]
+		redelegationsInfo = append(redelegationsInfo, redelegationInfo)
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"delegator":            unsafeDelegatorAddr,
+		"withdrawAddress":      resWithdrawAddr.WithdrawAddress,
+		"delegations":          delegationsInfo,
+		"unbondingDelegations": unbondingDelegationsInfo,
+		"redelegations":        redelegationsInfo,
+		"totalRewards":         berpcutils.CoinsToMap(truncateDecCoins(resDist.Total)...),
+	}, nil
+}
+
+// truncateDecCoins truncates the decimal coins into coins, the remainder is discarded.
+func truncateDecCoins(decCoins sdk.DecCoins) sdk.Coins {
+	coins, _ := decCoins.TruncateDecimal()
+	return coins
+}
+
+const (
+	ValidatorStatusFilterBonded    = "bonded"
+	ValidatorStatusFilterUnbonding = "unbonding"
+	ValidatorStatusFilterUnbonded  = "unbonded"
+	ValidatorStatusFilterJailed    = "jailed"
+	ValidatorStatusFilterAll       = "all"
+)
+
+const (
+	ValidatorSortByTokens     = "tokens"
+	ValidatorSortByCommission = "commission"
+	ValidatorSortByMoniker    = "moniker"
+	ValidatorSortByUptime     = "uptime"
+)
+
+func (m *Backend) GetValidators(statusFilter, sortBy string) (berpctypes.GenericBackendResponse, error) {
+	statusFilter = strings.ToLower(strings.TrimSpace(statusFilter))
+	if statusFilter == "" {
+		statusFilter = ValidatorStatusFilterBonded
+	}
+
+	var filter func(validator stakingtypes.Validator) bool
+	switch statusFilter {
+	case ValidatorStatusFilterBonded:
+		filter = func(validator stakingtypes.Validator) bool {
+			return validator.Status == stakingtypes.Bonded
+		}
+	case ValidatorStatusFilterUnbonding:
+		filter = func(validator stakingtypes.Validator) bool {
+			return validator.Status == stakingtypes.Unbonding
+		}
+	case ValidatorStatusFilterUnbonded:
+		filter = func(validator stakingtypes.Validator) bool {
+			return validator.Status == stakingtypes.Unbonded
+		}
+	case ValidatorStatusFilterJailed:
+		filter = func(validator stakingtypes.Validator) bool {
+			return validator.Jailed
+		}
+	case ValidatorStatusFilterAll:
+		filter = func(_ stakingtypes.Validator) bool {
+			return true
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("not supported status filter %s", statusFilter))
+	}
+
+	sortBy = strings.ToLower(strings.TrimSpace(sortBy))
+	if sortBy == "" {
+		sortBy = ValidatorSortByTokens
+	}
+	switch sortBy {
+	case ValidatorSortByTokens, ValidatorSortByCommission, ValidatorSortByMoniker, ValidatorSortByUptime:
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("not supported sort option %s", sortBy))
+	}
+
 	tmValidators, err := m.tendermintValidatorsCache.GetValidators()
 	if err != nil {
 		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get tendermint validators").Error())
 	}
 
-	stakingValidators, err := m.stakingValidatorsCache.GetValidators()
+	cachedStakingValidators, err := m.stakingValidatorsCache.GetValidators()
 	if err != nil {
 		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking validators").Error())
 	}
 
-	// take only active set
-	sort.Slice(stakingValidators, func(i, j int) bool {
-		return stakingValidators[i].validator.Tokens.GT(stakingValidators[j].validator.Tokens)
-	})
-	stakingParams, err := m.queryClient.StakingQueryClient.Params(m.ctx, &stakingtypes.QueryParamsRequest{})
+	// filter into a new slice, so the cached one will not be modified by sorting
+	stakingValidators := make([]cachedValidator, 0, len(cachedStakingValidators))
+	for _, stakingValidator := range cachedStakingValidators {
+		if filter(stakingValidator.validator) {
+			stakingValidators = append(stakingValidators, stakingValidator)
+		}
+	}
+
+	uptimeByConsAddr, err := m.getValidatorsUptime()
 	if err != nil {
-		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking params").Error())
+		m.GetLogger().Error("failed to get validators uptime", "error", err)
 	}
-	if stakingParams.Params.MaxValidators > 0 && uint32(len(stakingValidators)) > stakingParams.Params.MaxValidators {
-		stakingValidators = stakingValidators[:stakingParams.Params.MaxValidators]
+
+	sort.SliceStable(stakingValidators, func(i, j int) bool {
+		vi := stakingValidators[i]
+		vj := stakingValidators[j]
+		switch sortBy {
+		case ValidatorSortByCommission:
+			if !vi.validator.Commission.Rate.Equal(vj.validator.Commission.Rate) {
+				return vi.validator.Commission.Rate.LT(vj.validator.Commission.Rate)
+			}
+		case ValidatorSortByMoniker:
+			mi := strings.ToLower(vi.validator.Description.Moniker)
+			mj := strings.ToLower(vj.validator.Description.Moniker)
+			if mi != mj {
+				return mi < mj
+			}
+		case ValidatorSortByUptime:
+			ui, foundI := uptimeByConsAddr[vi.consAddr]
+			uj, foundJ := uptimeByConsAddr[vj.consAddr]
+			if foundI != foundJ {
+				return foundI
+			}
+			if ui != uj {
+				return ui > uj
+			}
+		}
+		// tokens descending, also used as tie-breaker for other sort options
+		return vi.validator.Tokens.GT(vj.validator.Tokens)
+	})
+
+	tmValidatorByConsAddr := make(map[string]*tmtypes.Validator, len(tmValidators))
+	for _, tmValidator := range tmValidators {
+		tmValidatorByConsAddr[sdk.ConsAddress(tmValidator.Address).String()] = tmValidator
 	}
 
-	// build response
-	res := make(berpctypes.GenericBackendResponse)
+	// build response, validators are returned as an array to preserve the requested sort order
+	validators := make([]map[string]any, 0, len(stakingValidators))
 
 	var bondTokenDecimals int
 	if m.externalServices.ChainType == berpctypes.ChainTypeEvm {
@@ -112,7 +363,7 @@ func (m *Backend) GetValidators() (berpctypes.GenericBackendResponse, error) {
 		bondTokenDecimals = 6
 	}
 
-	for _, stakingValidator := range stakingValidators {
+	for i, stakingValidator := range stakingValidators {
 		consAddr := stakingValidator.consAddr
 		valInfo := map[string]any{
 			"consAddress":    consAddr,
@@ -123,20 +374,869 @@ func (m *Backend) GetValidators() (berpctypes.GenericBackendResponse, error) {
 			"tokens":         stakingValidator.validator.Tokens,
 			"tokensDecimals": bondTokenDecimals,
 			"commission":     stakingValidator.validator.Commission.Rate,
+			"status":         stakingValidator.validator.Status.String(),
+			"jailed":         stakingValidator.validator.Jailed,
+			"rank":           i + 1,
 		}
 
-		for _, tmValidator := range tmValidators {
-			if sdk.ConsAddress(tmValidator.Address).String() != consAddr {
-				continue
-			}
+		if uptime, found := uptimeByConsAddr[consAddr]; found {
+			valInfo["uptime"] = uptime
+		}
 
+		if tmValidator, found := tmValidatorByConsAddr[consAddr]; found {
 			valInfo["pubKeyType"] = tmValidator.PubKey.Type()
 			valInfo["votingPower"] = tmValidator.VotingPower
-			break
 		}
 
-		res[consAddr] = valInfo
+		validators = append(validators, valInfo)
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"validators":   validators,
+		"statusFilter": statusFilter,
+		"sortBy":       sortBy,
+	}, nil
+}
+
+func (m *Backend) GetStakingEconomics() (berpctypes.GenericBackendResponse, error) {
+	stakingParams, err := m.queryClient.StakingQueryClient.Params(m.ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking params").Error())
+	}
+	bondDenom := stakingParams.Params.BondDenom
+
+	resPool, err := m.queryClient.StakingQueryClient.Pool(m.ctx, &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking pool").Error())
+	}
+
+	resSupply, err := m.queryClient.BankQueryClient.SupplyOf(m.ctx, &banktypes.QuerySupplyOfRequest{
+		Denom: bondDenom,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get supply of bond denom").Error())
+	}
+
+	resInflation, err := m.queryClient.MintQueryClient.Inflation(m.ctx, &minttypes.QueryInflationRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get inflation").Error())
+	}
+
+	resAnnualProvisions, err := m.queryClient.MintQueryClient.AnnualProvisions(m.ctx, &minttypes.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get annual provisions").Error())
+	}
+
+	distributionParams, err := m.queryClient.DistributionQueryClient.Params(m.ctx, &disttypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get distribution params").Error())
+	}
+
+	bondedTokens := resPool.Pool.BondedTokens
+	totalSupply := resSupply.Amount.Amount
+	inflation := resInflation.Inflation
+	annualProvisions := resAnnualProvisions.AnnualProvisions
+	communityTax := distributionParams.Params.CommunityTax
+
+	bondedRatio := sdk.ZeroDec()
+	if totalSupply.IsPositive() {
+		bondedRatio = sdk.NewDecFromInt(bondedTokens).QuoInt(totalSupply)
+	}
+
+	// nominal APR = annual provisions * (1 - community tax) / bonded tokens
+	nominalApr := sdk.ZeroDec()
+	if bondedTokens.IsPositive() {
+		nominalApr = annualProvisions.Mul(sdk.OneDec().Sub(communityTax)).QuoInt(bondedTokens)
+	}
+
+	// real APR is the nominal APR adjusted by inflation: (1 + nominal APR) / (1 + inflation) - 1
+	realApr := sdk.OneDec().Add(nominalApr).Quo(sdk.OneDec().Add(inflation)).Sub(sdk.OneDec())
+
+	stakingValidators, err := m.stakingValidatorsCache.GetValidators()
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking validators").Error())
+	}
+
+	validatorsApr := make(berpctypes.GenericBackendResponse)
+	for _, stakingValidator := range stakingValidators {
+		validator := stakingValidator.validator
+		if validator.Status != stakingtypes.Bonded {
+			continue
+		}
+
+		commissionRate := validator.Commission.CommissionRates.Rate
+		validatorsApr[validator.OperatorAddress] = map[string]any{
+			"moniker":    validator.Description.Moniker,
+			"commission": commissionRate.String(),
+			"apr":        nominalApr.Mul(sdk.OneDec().Sub(commissionRate)).String(),
+		}
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"bondDenom":        bondDenom,
+		"inflation":        inflation.String(),
+		"annualProvisions": annualProvisions.String(),
+		"pool": map[string]string{
+			"bonded":    bondedTokens.String(),
+			"notBonded": resPool.Pool.NotBondedTokens.String(),
+		},
+		"totalSupply":  totalSupply.String(),
+		"bondedRatio":  bondedRatio.String(),
+		"communityTax": communityTax.String(),
+		"apr": map[string]string{
+			"nominal": nominalApr.String(),
+			"real":    realApr.String(),
+		},
+		"validators": validatorsApr,
+	}, nil
+}
+
+// validatorQueryPageSize is the page size used to fetch all records related to a validator from staking module,
+// before sorting and paginating them.
+const validatorQueryPageSize = 1000
+
+// getLatestBlockHeight returns the latest block height, used to load and expire the cached validator records.
+func (m *Backend) getLatestBlockHeight() (int64, error) {
+	statusInfo, err := m.clientCtx.Client.Status(m.ctx)
+	if err != nil {
+		return 0, status.Error(codes.Internal, errors.Wrap(err, "failed to get status").Error())
+	}
+	return statusInfo.SyncInfo.LatestBlockHeight, nil
+}
+
+func (m *Backend) GetValidatorDelegations(valAddr string, pageNo int) (berpctypes.GenericBackendResponse, error) {
+	if pageNo < 1 {
+		return nil, berpctypes.ErrBadPageNo
+	}
+
+	valAddr = berpcutils.NormalizeAddress(valAddr)
+	if !m.bech32Cfg.IsValAddr(valAddr) {
+		return nil, berpctypes.ErrBadAddress
+	}
+
+	validator, err := m.findStakingValidator(valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	amountOf := func(delegation stakingtypes.DelegationResponse) sdk.Int {
+		return validator.validator.TokensFromShares(delegation.Delegation.Shares).TruncateInt()
+	}
+
+	latestHeight, err := m.getLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	ctx := berpcutils.QueryContextWithHeight(latestHeight)
+
+	delegations, err := m.validatorRecordsCache.GetDelegations(valAddr, latestHeight, func() ([]stakingtypes.DelegationResponse, error) {
+		delegations := make([]stakingtypes.DelegationResponse, 0)
+
+		var nextKey []byte
+		for {
+			resDelegations, err := m.queryClient.StakingQueryClient.ValidatorDelegations(ctx, &stakingtypes.QueryValidatorDelegationsRequest{
+				ValidatorAddr: valAddr,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: validatorQueryPageSize,
+				},
+			})
+			if err != nil {
+				return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get validator delegations").Error())
+			}
+
+			delegations = append(delegations, resDelegations.DelegationResponses...)
+
+			if resDelegations.Pagination == nil || len(resDelegations.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = resDelegations.Pagination.NextKey
+		}
+
+		sort.SliceStable(delegations, func(i, j int) bool {
+			return amountOf(delegations[i]).GT(amountOf(delegations[j]))
+		})
+
+		return delegations, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := getPageRange(pageNo, defaultPageSize, len(delegations))
+
+	delegationsInfo := make([]berpctypes.GenericBackendResponse, 0)
+	for _, delegation := range delegations[from:to] {
+		delegationsInfo = append(delegationsInfo, berpctypes.GenericBackendResponse{
+			"delegator": delegation.Delegation.DelegatorAddress,
+			"shares":    delegation.Delegation.Shares.String(),
+			"amount":    berpcutils.CoinsToMap(sdk.NewCoin(delegation.Balance.Denom, amountOf(delegation))),
+		})
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"validator":   valAddr,
+		"moniker":     validator.validator.Description.Moniker,
+		"delegations": delegationsInfo,
+		"total":       len(delegations),
+		"pageNo":      pageNo,
+		"pageSize":    defaultPageSize,
+	}, nil
+}
+
+func (m *Backend) GetValidatorUnbondingDelegations(valAddr string, pageNo int) (berpctypes.GenericBackendResponse, error) {
+	if pageNo < 1 {
+		return nil, berpctypes.ErrBadPageNo
+	}
+
+	valAddr = berpcutils.NormalizeAddress(valAddr)
+	if !m.bech32Cfg.IsValAddr(valAddr) {
+		return nil, berpctypes.ErrBadAddress
+	}
+
+	validator, err := m.findStakingValidator(valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingParams, err := m.queryClient.StakingQueryClient.Params(m.ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking params").Error())
+	}
+	bondDenom := stakingParams.Params.BondDenom
+
+	totalBalanceOf := func(unbondingDelegation stakingtypes.UnbondingDelegation) sdk.Int {
+		total := sdk.ZeroInt()
+		for _, entry := range unbondingDelegation.Entries {
+			total = total.Add(entry.Balance)
+		}
+		return total
+	}
+
+	latestHeight, err := m.getLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	ctx := berpcutils.QueryContextWithHeight(latestHeight)
+
+	unbondingDelegations, err := m.validatorRecordsCache.GetUnbondingDelegations(valAddr, latestHeight, func() ([]stakingtypes.UnbondingDelegation, error) {
+		unbondingDelegations := make([]stakingtypes.UnbondingDelegation, 0)
+
+		var nextKey []byte
+		for {
+			resUnbondingDelegations, err := m.queryClient.StakingQueryClient.ValidatorUnbondingDelegations(ctx, &stakingtypes.QueryValidatorUnbondingDelegationsRequest{
+				ValidatorAddr: valAddr,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: validatorQueryPageSize,
+				},
+			})
+			if err != nil {
+				return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get validator unbonding delegations").Error())
+			}
+
+			unbondingDelegations = append(unbondingDelegations, resUnbondingDelegations.UnbondingResponses...)
+
+			if resUnbondingDelegations.Pagination == nil || len(resUnbondingDelegations.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = resUnbondingDelegations.Pagination.NextKey
+		}
+
+		sort.SliceStable(unbondingDelegations, func(i, j int) bool {
+			return totalBalanceOf(unbondingDelegations[i]).GT(totalBalanceOf(unbondingDelegations[j]))
+		})
+
+		return unbondingDelegations, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := getPageRange(pageNo, defaultPageSize, len(unbondingDelegations))
+
+	unbondingDelegationsInfo := make([]berpctypes.GenericBackendResponse, 0)
+	for _, unbondingDelegation := range unbondingDelegations[from:to] {
+		unbondingDelegationsInfo = append(unbondingDelegationsInfo, berpctypes.GenericBackendResponse{
+			"delegator": unbondingDelegation.DelegatorAddress,
+			"amount":    berpcutils.CoinsToMap(sdk.NewCoin(bondDenom, totalBalanceOf(unbondingDelegation))),
+			"entries":   unbondingEntriesToResponse(unbondingDelegation.Entries, bondDenom),
+		})
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"validator":            valAddr,
+		"moniker":              validator.validator.Description.Moniker,
+		"unbondingDelegations": unbondingDelegationsInfo,
+		"total":                len(unbondingDelegations),
+		"pageNo":               pageNo,
+		"pageSize":             defaultPageSize,
+	}, nil
+}
+
+func (m *Backend) GetValidatorRedelegations(valAddr string, pageNo int) (berpctypes.GenericBackendResponse, error) {
+	if pageNo < 1 {
+		return nil, berpctypes.ErrBadPageNo
+	}
+
+	valAddr = berpcutils.NormalizeAddress(valAddr)
+	if !m.bech32Cfg.IsValAddr(valAddr) {
+		return nil, berpctypes.ErrBadAddress
+	}
+
+	validator, err := m.findStakingValidator(valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingParams, err := m.queryClient.StakingQueryClient.Params(m.ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking params").Error())
+	}
+	bondDenom := stakingParams.Params.BondDenom
+
+	latestHeight, err := m.getLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	ctx := berpcutils.QueryContextWithHeight(latestHeight)
+
+	redelegations, err := m.validatorRecordsCache.GetRedelegations(valAddr, latestHeight, func() ([]stakingtypes.RedelegationResponse, error) {
+		redelegations := make([]stakingtypes.RedelegationResponse, 0)
+
+		var nextKey []byte
+		for {
+			resRedelegations, err := m.queryClient.StakingQueryClient.Redelegations(ctx, &stakingtypes.QueryRedelegationsRequest{
+				SrcValidatorAddr: valAddr,
+				Pagination: &query.PageRequest{
+					Key:   nextKey,
+					Limit: validatorQueryPageSize,
+				},
+			})
+			if err != nil {
+				return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get validator re-delegations").Error())
+			}
+
+			redelegations = append(redelegations, resRedelegations.RedelegationResponses...)
+
+			if resRedelegations.Pagination == nil || len(resRedelegations.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = resRedelegations.Pagination.NextKey
+		}
+
+		sort.SliceStable(redelegations, func(i, j int) bool {
+			return totalRedelegationBalanceOf(redelegations[i]).GT(totalRedelegationBalanceOf(redelegations[j]))
+		})
+
+		return redelegations, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := getPageRange(pageNo, defaultPageSize, len(redelegations))
+
+	redelegationsInfo := make([]berpctypes.GenericBackendResponse, 0)
+	for _, redelegation := range redelegations[from:to] {
+		redelegationsInfo = append(redelegationsInfo, redelegationToResponse(redelegation, bondDenom))
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"validator":     valAddr,
+		"moniker":       validator.validator.Description.Moniker,
+		"redelegations": redelegationsInfo,
+		"total":         len(redelegations),
+		"pageNo":        pageNo,
+		"pageSize":      defaultPageSize,
+	}, nil
+}
+
+func unbondingEntriesToResponse(entries []stakingtypes.UnbondingDelegationEntry, bondDenom string) []berpctypes.GenericBackendResponse {
+	entriesInfo := make([]berpctypes.GenericBackendResponse, 0, len(entries))
+	for _, entry := range entries {
+		entriesInfo = append(entriesInfo, berpctypes.GenericBackendResponse{
+			"creationHeight":         entry.CreationHeight,
+			"completionTimeEpochUTC": entry.CompletionTime.UTC().Unix(),
+			"initialBalance":         berpcutils.CoinsToMap(sdk.NewCoin(bondDenom, entry.InitialBalance)),
+			"balance":                berpcutils.CoinsToMap(sdk.NewCoin(bondDenom, entry.Balance)),
+		})
+	}
+	return entriesInfo
+}
+
+func totalRedelegationBalanceOf(redelegation stakingtypes.RedelegationResponse) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, entry := range redelegation.Entries {
+		total = total.Add(entry.Balance)
+	}
+	return total
+}
+
+func redelegationToResponse(redelegation stakingtypes.RedelegationResponse, bondDenom string) berpctypes.GenericBackendResponse {
+	entriesInfo := make([]berpctypes.GenericBackendResponse, 0, len(redelegation.Entries))
+	for _, entry := range redelegation.Entries {
+		entriesInfo = append(entriesInfo, berpctypes.GenericBackendResponse{
+			"creationHeight":         entry.RedelegationEntry.CreationHeight,
+			"completionTimeEpochUTC": entry.RedelegationEntry.CompletionTime.UTC().Unix(),
+			"initialBalance":         berpcutils.CoinsToMap(sdk.NewCoin(bondDenom, entry.RedelegationEntry.InitialBalance)),
+			"balance":                berpcutils.CoinsToMap(sdk.NewCoin(bondDenom, entry.Balance)),
+			"sharesDst":              entry.RedelegationEntry.SharesDst.String(),
+		})
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"delegator":     redelegation.Redelegation.DelegatorAddress,
+		"validatorFrom": redelegation.Redelegation.ValidatorSrcAddress,
+		"validatorTo":   redelegation.Redelegation.ValidatorDstAddress,
+		"amount":        berpcutils.CoinsToMap(sdk.NewCoin(bondDenom, totalRedelegationBalanceOf(redelegation))),
+		"entries":       entriesInfo,
+	}
+}
+
+const (
+	defaultValidatorUptimeWindow = 100
+	maxValidatorUptimeWindow     = 500
+
+	// validatorUptimeCommitsBatchSize is the number of commits to be fetched per batch request.
+	validatorUptimeCommitsBatchSize = 100
+)
+
+func (m *Backend) GetValidatorUptime(consOrValAddr string, window int64) (berpctypes.GenericBackendResponse, error) {
+	consOrValAddr = berpcutils.NormalizeAddress(consOrValAddr)
+	if !m.bech32Cfg.IsValAddr(consOrValAddr) && !m.bech32Cfg.IsConsAddr(consOrValAddr) {
+		return nil, berpctypes.ErrBadAddress
+	}
+	if window == 0 {
+		window = defaultValidatorUptimeWindow
+	}
+	if window < 1 || window > maxValidatorUptimeWindow {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("window must be in range 1 to %d", maxValidatorUptimeWindow))
+	}
+
+	validator, err := m.findStakingValidator(consOrValAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(validator.consAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to parse consensus address").Error())
+	}
+
+	statusInfo, err := m.clientCtx.Client.Status(m.ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	latestBlockNumber := statusInfo.SyncInfo.LatestBlockHeight
+
+	res := berpctypes.GenericBackendResponse{
+		"address": berpctypes.GenericBackendResponse{
+			"validatorAddress": validator.validator.OperatorAddress,
+			"consensusAddress": validator.consAddr,
+		},
+		"moniker": validator.validator.Description.Moniker,
+	}
+
+	resSlashingParams, err := m.queryClient.SlashingQueryClient.Params(m.ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get slashing params").Error())
+	}
+
+	resSigningInfo, err := m.queryClient.SlashingQueryClient.SigningInfo(m.ctx, &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: validator.consAddr,
+	})
+	if err != nil {
+		// validator which never signed any block does not have signing info
+		m.GetLogger().Error("failed to get validator signing info", "consAddr", validator.consAddr, "error", err)
+	} else {
+		signingInfo := resSigningInfo.ValSigningInfo
+		res["signingInfo"] = berpctypes.GenericBackendResponse{
+			"startHeight":         signingInfo.StartHeight,
+			"indexOffset":         signingInfo.IndexOffset,
+			"jailedUntilEpochUTC": signingInfo.JailedUntil.UTC().Unix(),
+			"tombstoned":          signingInfo.Tombstoned,
+			"missedBlocksCounter": signingInfo.MissedBlocksCounter,
+			"signedBlocksWindow":  resSlashingParams.Params.SignedBlocksWindow,
+			"minSignedPerWindow":  resSlashingParams.Params.MinSignedPerWindow.String(),
+		}
+		res["uptime"] = calculateUptimePercentage(signingInfo, resSlashingParams.Params.SignedBlocksWindow, latestBlockNumber)
+	}
+
+	fromHeight := tmmath.MaxInt64(tmmath.MaxInt64(1, statusInfo.SyncInfo.EarliestBlockHeight), latestBlockNumber-window+1)
+
+	// bitmap of recent blocks, from the oldest to the latest:
+	//   - '1' means signed
+	//   - '0' means missed
+	//   - '-' means not in the active validator set at that height
+	var bitmap strings.Builder
+	var signedCount, missedCount int64
+
+	commits, err := m.getCommits(fromHeight, latestBlockNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get commits").Error())
+	}
+
+	// validator set rarely changes, so membership is cached by the validator set hash,
+	// to prevent querying the validator set for every block
+	inSetByValidatorsHash := make(map[string]bool)
+
+	for i, resCommit := range commits {
+		height := fromHeight + int64(i)
+
+		var foundSignature, signed bool
+		for _, commitSig := range resCommit.Commit.Signatures {
+			if bytes.Equal(commitSig.ValidatorAddress, consAddr) {
+				foundSignature = true
+				signed = !commitSig.Absent()
+				break
+			}
+		}
+
+		if !foundSignature {
+			// absent signature does not carry validator address, so need to check the validator set
+			validatorsHash := resCommit.Header.ValidatorsHash.String()
+			inSet, cached := inSetByValidatorsHash[validatorsHash]
+			if !cached {
+				inSet, err = m.isInValidatorSetAtHeight(consAddr, height)
+				if err != nil {
+					return nil, status.Error(codes.Internal, errors.Wrap(err, fmt.Sprintf("failed to get validators %d", height)).Error())
+				}
+				inSetByValidatorsHash[validatorsHash] = inSet
+			}
+			if !inSet {
+				bitmap.WriteByte('-')
+				continue
+			}
+		}
+
+		if signed {
+			signedCount++
+			bitmap.WriteByte('1')
+		} else {
+			missedCount++
+			bitmap.WriteByte('0')
+		}
+	}
+
+	res["recentBlocks"] = berpctypes.GenericBackendResponse{
+		"fromHeight":  fromHeight,
+		"toHeight":    latestBlockNumber,
+		"bitmap":      bitmap.String(),
+		"signedCount": signedCount,
+		"missedCount": missedCount,
 	}
 
 	return res, nil
 }
+
+const (
+	nakamotoCoefficientHaltThreshold    = 0.334
+	nakamotoCoefficientControlThreshold = 0.667
+)
+
+// defaultVotingPowerTopN is the default number of top validators to compute the concentration of voting power.
+const defaultVotingPowerTopN = 10
+
+func (m *Backend) GetVotingPowerDistribution(height int64, topN int) (berpctypes.GenericBackendResponse, error) {
+	if height < 0 {
+		return nil, berpctypes.ErrBadRequest
+	}
+	if topN == 0 {
+		topN = defaultVotingPowerTopN
+	}
+	if topN < 1 {
+		return nil, status.Error(codes.InvalidArgument, "top N must be positive")
+	}
+
+	var tmValidators []*tmtypes.Validator
+	var err error
+	if height == 0 {
+		tmValidators, err = m.tendermintValidatorsCache.GetValidators()
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to get tendermint validators").Error())
+		}
+	} else {
+		tmValidators, err = m.getTendermintValidatorsAtHeight(height)
+		if err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrap(err, fmt.Sprintf("failed to get validators %d", height)).Error())
+		}
+	}
+
+	sortedValidators := make([]*tmtypes.Validator, len(tmValidators))
+	copy(sortedValidators, tmValidators)
+	sort.SliceStable(sortedValidators, func(i, j int) bool {
+		return sortedValidators[i].VotingPower > sortedValidators[j].VotingPower
+	})
+
+	votingPowers := make([]int64, len(sortedValidators))
+	var totalVotingPower int64
+	for i, validator := range sortedValidators {
+		votingPowers[i] = validator.VotingPower
+		totalVotingPower += validator.VotingPower
+	}
+
+	percentageOf := func(votingPower int64) float64 {
+		if totalVotingPower < 1 {
+			return 0
+		}
+		return math.Round(float64(votingPower)*100*100/float64(totalVotingPower)) / 100
+	}
+
+	monikerByConsAddr := m.getValidatorMonikersByConsensusAddress()
+
+	validatorsInfo := make([]berpctypes.GenericBackendResponse, 0, len(sortedValidators))
+	var cumulativeVotingPower, topNVotingPower int64
+	for i, validator := range sortedValidators {
+		cumulativeVotingPower += validator.VotingPower
+		if i < topN {
+			topNVotingPower += validator.VotingPower
+		}
+
+		consAddr := sdk.ConsAddress(validator.Address).String()
+		validatorsInfo = append(validatorsInfo, berpctypes.GenericBackendResponse{
+			"consensusAddress": consAddr,
+			"moniker":          monikerByConsAddr[consAddr],
+			"votingPower":      validator.VotingPower,
+			"share":            percentageOf(validator.VotingPower),
+			"cumulativeShare":  percentageOf(cumulativeVotingPower),
+		})
+	}
+
+	return berpctypes.GenericBackendResponse{
+		"height":           height,
+		"validatorsCount":  len(sortedValidators),
+		"totalVotingPower": totalVotingPower,
+		"nakamotoCoefficient": map[string]int{
+			"0.334": berpcutils.NakamotoCoefficient(votingPowers, nakamotoCoefficientHaltThreshold),
+			"0.667": berpcutils.NakamotoCoefficient(votingPowers, nakamotoCoefficientControlThreshold),
+		},
+		"gini": math.Round(berpcutils.GiniCoefficient(votingPowers)*10000) / 10000,
+		"topN": map[string]any{
+			"n":     topN,
+			"share": percentageOf(topNVotingPower),
+		},
+		"validators": validatorsInfo,
+	}, nil
+}
+
+// getTendermintValidatorsAtHeight returns the complete Tendermint validator set at the given height.
+func (m *Backend) getTendermintValidatorsAtHeight(height int64) ([]*tmtypes.Validator, error) {
+	var page = 1
+	var perPage = 100
+
+	validators := make([]*tmtypes.Validator, 0)
+	for {
+		resValidators, err := m.clientCtx.Client.Validators(m.ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, resValidators.Validators...)
+
+		if len(resValidators.Validators) < perPage || len(validators) >= resValidators.Total {
+			return validators, nil
+		}
+
+		page++
+	}
+}
+
+// getCommits returns the commits of the blocks within the given range, inclusive, ordered by height.
+// When connected to the node via HTTP, commits are fetched using batch requests.
+func (m *Backend) getCommits(fromHeight, toHeight int64) ([]*coretypes.ResultCommit, error) {
+	commits := make([]*coretypes.ResultCommit, 0, toHeight-fromHeight+1)
+
+	httpClient, isHttpClient := m.clientCtx.Client.(*rpchttp.HTTP)
+	if !isHttpClient {
+		for height := fromHeight; height <= toHeight; height++ {
+			h := height
+			resCommit, err := m.clientCtx.Client.Commit(m.ctx, &h)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to get commit %d", height))
+			}
+			commits = append(commits, resCommit)
+		}
+
+		return commits, nil
+	}
+
+	for batchFromHeight := fromHeight; batchFromHeight <= toHeight; batchFromHeight += validatorUptimeCommitsBatchSize {
+		batchToHeight := tmmath.MinInt64(batchFromHeight+validatorUptimeCommitsBatchSize-1, toHeight)
+
+		batch := httpClient.NewBatch()
+		batchCommits := make([]*coretypes.ResultCommit, 0, batchToHeight-batchFromHeight+1)
+		for height := batchFromHeight; height <= batchToHeight; height++ {
+			h := height
+			resCommit, err := batch.Commit(m.ctx, &h)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to prepare commit request %d", height))
+			}
+			batchCommits = append(batchCommits, resCommit)
+		}
+
+		if _, err := batch.Send(m.ctx); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to get commits %d to %d", batchFromHeight, batchToHeight))
+		}
+
+		commits = append(commits, batchCommits...)
+	}
+
+	return commits, nil
+}
+
+// isInValidatorSetAtHeight returns true if the validator is in the Tendermint validator set at the given height.
+func (m *Backend) isInValidatorSetAtHeight(consAddr sdk.ConsAddress, height int64) (bool, error) {
+	var page = 1
+	var perPage = 100
+
+	for {
+		resValidators, err := m.clientCtx.Client.Validators(m.ctx, &height, &page, &perPage)
+		if err != nil {
+			return false, err
+		}
+
+		for _, validator := range resValidators.Validators {
+			if bytes.Equal(validator.Address, consAddr) {
+				return true, nil
+			}
+		}
+
+		if len(resValidators.Validators) < perPage || page*perPage >= resValidators.Total {
+			return false, nil
+		}
+
+		page++
+	}
+}
+
+// getValidatorsUptime returns the uptime percentage of all validators which have signing info, by consensus address.
+func (m *Backend) getValidatorsUptime() (map[string]float64, error) {
+	statusInfo, err := m.clientCtx.Client.Status(m.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resSlashingParams, err := m.queryClient.SlashingQueryClient.Params(m.ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get slashing params")
+	}
+
+	uptimeByConsAddr := make(map[string]float64)
+
+	var nextKey []byte
+	for {
+		resSigningInfos, err := m.queryClient.SlashingQueryClient.SigningInfos(m.ctx, &slashingtypes.QuerySigningInfosRequest{
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: 200,
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get signing infos")
+		}
+
+		for _, signingInfo := range resSigningInfos.Info {
+			uptimeByConsAddr[signingInfo.Address] = calculateUptimePercentage(
+				signingInfo,
+				resSlashingParams.Params.SignedBlocksWindow,
+				statusInfo.SyncInfo.LatestBlockHeight,
+			)
+		}
+
+		if resSigningInfos.Pagination == nil || len(resSigningInfos.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = resSigningInfos.Pagination.NextKey
+	}
+
+	return uptimeByConsAddr, nil
+}
+
+// calculateUptimePercentage returns the uptime percentage of a validator within the slashing signed blocks window,
+// rounded to 2 decimal places.
+func calculateUptimePercentage(signingInfo slashingtypes.ValidatorSigningInfo, signedBlocksWindow, latestHeight int64) float64 {
+	window := signedBlocksWindow
+	if blocksSinceStart := latestHeight - signingInfo.StartHeight + 1; blocksSinceStart < window {
+		window = blocksSinceStart
+	}
+	if window < 1 {
+		return 100
+	}
+
+	missed := tmmath.MinInt64(signingInfo.MissedBlocksCounter, window)
+	uptime := float64(window-missed) * 100 / float64(window)
+
+	return math.Round(uptime*100) / 100
+}
+
+// getValidatorMonikersByConsensusAddress returns the mapping from validator consensus address to moniker.
+// Any error is logged and the result will be empty.
+func (m *Backend) getValidatorMonikersByConsensusAddress() map[string]string {
+	monikerByConsAddr := make(map[string]string)
+
+	stakingValidators, err := m.stakingValidatorsCache.GetValidators()
+	if err != nil {
+		m.GetLogger().Error("failed to get staking validators", "error", err)
+		return monikerByConsAddr
+	}
+
+	for _, stakingValidator := range stakingValidators {
+		monikerByConsAddr[stakingValidator.consAddr] = stakingValidator.validator.Description.Moniker
+	}
+
+	return monikerByConsAddr
+}
+
+// getValidatorMonikersByOperatorAddress returns the mapping from validator operator address to moniker.
+// Any error is logged and the result will be empty.
+func (m *Backend) getValidatorMonikersByOperatorAddress() map[string]string {
+	monikerByValAddr := make(map[string]string)
+
+	stakingValidators, err := m.stakingValidatorsCache.GetValidators()
+	if err != nil {
+		m.GetLogger().Error("failed to get staking validators", "error", err)
+		return monikerByValAddr
+	}
+
+	for _, stakingValidator := range stakingValidators {
+		monikerByValAddr[stakingValidator.validator.OperatorAddress] = stakingValidator.validator.Description.Moniker
+	}
+
+	return monikerByValAddr
+}
+
+// getValidatorsByAccountAddress returns the mapping from account address of validator operator to the validator.
+// Any error is logged and the result will be empty.
+func (m *Backend) getValidatorsByAccountAddress() map[string]cachedValidator {
+	validatorByAccAddr := make(map[string]cachedValidator)
+
+	stakingValidators, err := m.stakingValidatorsCache.GetValidators()
+	if err != nil {
+		m.GetLogger().Error("failed to get staking validators", "error", err)
+		return validatorByAccAddr
+	}
+
+	for _, stakingValidator := range stakingValidators {
+		accAddr := m.bech32Cfg.FromAnyToBech32AccountAddrUnsafe(stakingValidator.validator.OperatorAddress)
+		validatorByAccAddr[accAddr] = stakingValidator
+	}
+
+	return validatorByAccAddr
+}
+
+// findStakingValidator finds the staking validator by consensus address or validator operator address.
+func (m *Backend) findStakingValidator(consOrValAddr string) (cachedValidator, error) {
+	stakingValidators, err := m.stakingValidatorsCache.GetValidators()
+	if err != nil {
+		return cachedValidator{}, status.Error(codes.Internal, errors.Wrap(err, "failed to get staking validators").Error())
+	}
+
+	for _, stakingValidator := range stakingValidators {
+		if stakingValidator.consAddr == consOrValAddr || stakingValidator.validator.OperatorAddress == consOrValAddr {
+			return stakingValidator, nil
+		}
+	}
+
+	return cachedValidator{}, status.Error(codes.NotFound, "validator could not be found")
+}
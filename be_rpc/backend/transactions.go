@@ -24,6 +24,7 @@ import (
 	ibctypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
@@ -208,26 +209,8 @@ func (m *Backend) getTransactionsInBlock(height int64) (blockInfo map[string]any
 				}
 			}
 
-			var messageInvolversExtractor berpctypes.MessageInvolversExtractor
-			if extractor, found := m.messageInvolversExtractors[berpcutils.ProtoMessageName(cosmosMsg)]; found {
-				messageInvolversExtractor = extractor
-			} else {
-				messageInvolversExtractor = m.defaultMessageInvolversExtractor
-			}
-
-			resInvolvers, errExtractInvolvers := messageInvolversExtractor(cosmosMsg, tx, tmTx, m.clientCtx)
+			resInvolvers, errExtractInvolvers := m.extractMessageInvolvers(cosmosMsg, tx, tmTx)
 			if errExtractInvolvers == nil {
-				if _, found := resInvolvers.GenericInvolvers()[berpctypes.MessageSenderSigner]; !found {
-					// if no signer found, try to get it from the signers
-					func() {
-						defer func() {
-							_ = recover() // omit any error
-						}()
-						if len(cosmosMsg.GetSigners()) > 0 {
-							resInvolvers.AddGenericInvolvers(berpctypes.MessageSenderSigner, cosmosMsg.GetSigners()[0].String())
-						}
-					}()
-				}
 				involvers = resInvolvers
 			} else {
 				m.GetLogger().Error("failed to extract involvers", "error", errExtractInvolvers)
@@ -351,6 +334,13 @@ func (m *Backend) getTransactionsInBlock(height int64) (blockInfo map[string]any
 		}
 		if !txValue.IsZero() {
 			txInfo["value"] = txValue.String()
+			if denomTraces := m.getIbcDenomTraces(txValue); len(denomTraces) > 0 {
+				txInfo["denomTraces"] = denomTraces
+			}
+		}
+		m.addBalanceChangesIntoResponse(txResponse.TxResponse.Events, txInfo)
+		if failure := getTxFailureInfo(txResponse.TxResponse); failure != nil {
+			txInfo["failure"] = failure
 		}
 		txsInfo = append(txsInfo, txInfo)
 	}
@@ -390,6 +380,184 @@ func (m *Backend) GetTransactionByHash(hashStr string) (berpctypes.GenericBacken
 	txRes := res.TxResponse
 	txEvents := berpctypes.ConvertTxEvent(txRes.Events).RemoveUnnecessaryEvmTxEvents()
 
+	msgsInfo, err := m.parseTransactionMessages(tx, txRes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := berpctypes.GenericBackendResponse{
+		"code":    txRes.Code,
+		"success": txRes.Code == 0,
+		"gas": berpctypes.GenericBackendResponse{
+			"limit": txRes.GasWanted,
+			"used":  txRes.GasUsed,
+		},
+		"events": txEvents,
+	}
+	if failure := getTxFailureInfo(txRes); failure != nil {
+		result["failure"] = failure
+	}
+
+	response := berpctypes.GenericBackendResponse{
+		"height": txRes.Height,
+		"hash":   txRes.TxHash,
+		"msgs":   msgsInfo,
+		"result": result,
+	}
+
+	addMemoIntoResponse(tx.Body.Memo, response)
+	m.addAuthInfoIntoResponse(tx, response)
+	m.addBalanceChangesIntoResponse(txRes.Events, response)
+
+	return response, nil
+}
+
+// protoTxProvider is implemented by the tx wrapper of the default TxConfig, provides access to the proto tx.
+type protoTxProvider interface {
+	GetProtoTx() *tx.Tx
+}
+
+func (m *Backend) DecodeTransaction(txBytes string) (berpctypes.GenericBackendResponse, error) {
+	bz, err := berpcutils.DecodeTxBytesString(txBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	decodedTx, err := m.decodeTxBytes(bz)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "failed to decode tx").Error())
+	}
+
+	tmTx := tmtypes.Tx(bz)
+	txHash := fmt.Sprintf("%X", tmTx.Hash())
+
+	// the tx is not executed yet so there is no result, only the hash is provided to the message parsers
+	msgsInfo, err := m.parseTransactionMessages(decodedTx, &sdk.TxResponse{
+		TxHash: txHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	involvers := berpctypes.NewMessageInvolversResult()
+	for _, msg := range decodedTx.Body.Messages {
+		var cosmosMsg sdk.Msg
+		if err := m.clientCtx.Codec.UnpackAny(msg, &cosmosMsg); err != nil {
+			return nil, status.Error(codes.Internal, errors.Wrapf(err, "failed to unpack message").Error())
+		}
+
+		resInvolvers, err := m.extractMessageInvolvers(cosmosMsg, decodedTx, tmTx)
+		if err != nil {
+			m.GetLogger().Error("failed to extract involvers", "error", err)
+			continue
+		}
+
+		involvers.Merge(resInvolvers)
+	}
+	involvers.Finalize()
+
+	response := berpctypes.GenericBackendResponse{
+		"hash":      txHash,
+		"msgs":      msgsInfo,
+		"involvers": involvers.ToResponseObject(),
+	}
+
+	if fee := decodedTx.AuthInfo.Fee; fee != nil {
+		response["gas"] = berpctypes.GenericBackendResponse{
+			"limit": fee.GasLimit,
+		}
+	}
+
+	addMemoIntoResponse(decodedTx.Body.Memo, response)
+	m.addAuthInfoIntoResponse(decodedTx, response)
+
+	return response, nil
+}
+
+// decodeTxBytes decodes the raw tx bytes using the TxConfig of the app.
+func (m *Backend) decodeTxBytes(bz []byte) (*tx.Tx, error) {
+	sdkTx, err := m.clientCtx.TxConfig.TxDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, ok := sdkTx.(protoTxProvider)
+	if !ok {
+		return nil, fmt.Errorf("decoded tx of type %T does not provide proto tx", sdkTx)
+	}
+
+	return provider.GetProtoTx(), nil
+}
+
+// addBalanceChangesIntoResponse adds the net balance changes by address and denom,
+// computed from the bank coin spent/received events of the tx.
+func (m *Backend) addBalanceChangesIntoResponse(events []abci.Event, response map[string]any) {
+	balanceChanges, err := berpcutils.GetBalanceChangesFromEvents(events)
+	if err != nil {
+		m.GetLogger().Error("failed to get balance changes from events", "error", err)
+		return
+	}
+
+	if len(balanceChanges) > 0 {
+		response["balanceChanges"] = balanceChanges.ToResponse()
+	}
+}
+
+// getTxFailureInfo decodes the failure of the tx: codespace, registered error name and description,
+// index of the failed message and, for EVM txs, the VM error and the revert reason.
+// Returns nil if the tx was executed successfully.
+func getTxFailureInfo(txRes *sdk.TxResponse) map[string]any {
+	failure := make(map[string]any)
+
+	if txRes.Code != 0 {
+		failure["code"] = txRes.Code
+		failure["codespace"] = txRes.Codespace
+		failure["log"] = txRes.RawLog
+
+		if name, found := berpcutils.GetSdkErrorName(txRes.Codespace, txRes.Code); found {
+			failure["errorName"] = name
+		}
+		if description, found := berpcutils.GetRegisteredErrorDescription(txRes.Codespace, txRes.Code); found {
+			failure["errorDescription"] = description
+		}
+		if msgIdx, found := berpcutils.ParseFailedMessageIndex(txRes.RawLog); found {
+			failure["msgIndex"] = msgIdx
+		}
+	}
+
+	// failed EVM execution does not fail the tx, the VM error is stored in the tx result data
+	if vmError, ret, found := berpcutils.GetEvmTxResultFromTxData(txRes.Data); found && len(vmError) > 0 {
+		evmFailure := map[string]any{
+			"vmError": vmError,
+		}
+		if revertReason, ok := berpcutils.DecodeEvmRevertReason(ret); ok {
+			evmFailure["revertReason"] = revertReason
+		}
+		failure["evm"] = evmFailure
+	}
+
+	if len(failure) == 0 {
+		return nil
+	}
+
+	return failure
+}
+
+func addMemoIntoResponse(memo string, response berpctypes.GenericBackendResponse) {
+	if len(memo) < 1 {
+		return
+	}
+
+	response["memo"] = memo
+
+	if ibcMemo, ok := berpcutils.DecodeIbcMemo(memo); ok {
+		response["ibcMemo"] = ibcMemoToResponse(ibcMemo)
+	}
+}
+
+// parseTransactionMessages parses each message of the tx using the registered message parser,
+// or the default message parser if none registered for the message type.
+func (m *Backend) parseTransactionMessages(tx *tx.Tx, txRes *sdk.TxResponse) ([]map[string]any, error) {
 	msgsInfo := make([]map[string]any, 0)
 	for msgIdx, msg := range tx.Body.Messages {
 		var cosmosMsg sdk.Msg
@@ -431,26 +599,38 @@ func (m *Backend) GetTransactionByHash(hashStr string) (berpctypes.GenericBacken
 		}
 	}
 
-	response := berpctypes.GenericBackendResponse{
-		"height": txRes.Height,
-		"hash":   txRes.TxHash,
-		"msgs":   msgsInfo,
-		"result": berpctypes.GenericBackendResponse{
-			"code":    txRes.Code,
-			"success": txRes.Code == 0,
-			"gas": berpctypes.GenericBackendResponse{
-				"limit": txRes.GasWanted,
-				"used":  txRes.GasUsed,
-			},
-			"events": txEvents,
-		},
+	return msgsInfo, nil
+}
+
+// extractMessageInvolvers extracts the involvers of the message using the registered message involvers extractor,
+// or the default extractor if none registered for the message type.
+// If no signer was provided by the extractor, the first signer of the message will be used.
+func (m *Backend) extractMessageInvolvers(cosmosMsg sdk.Msg, tx *tx.Tx, tmTx tmtypes.Tx) (berpctypes.MessageInvolversResult, error) {
+	var messageInvolversExtractor berpctypes.MessageInvolversExtractor
+	if extractor, found := m.messageInvolversExtractors[berpcutils.ProtoMessageName(cosmosMsg)]; found {
+		messageInvolversExtractor = extractor
+	} else {
+		messageInvolversExtractor = m.defaultMessageInvolversExtractor
+	}
+
+	resInvolvers, err := messageInvolversExtractor(cosmosMsg, tx, tmTx, m.clientCtx)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(tx.Body.Memo) > 0 {
-		response["memo"] = tx.Body.Memo
+	if _, found := resInvolvers.GenericInvolvers()[berpctypes.MessageSenderSigner]; !found {
+		// if no signer found, try to get it from the signers
+		func() {
+			defer func() {
+				_ = recover() // omit any error
+			}()
+			if len(cosmosMsg.GetSigners()) > 0 {
+				resInvolvers.AddGenericInvolvers(berpctypes.MessageSenderSigner, cosmosMsg.GetSigners()[0].String())
+			}
+		}()
 	}
 
-	return response, nil
+	return resInvolvers, nil
 }
 
 func (m *Backend) getEvmTransactionInfo(txHash string) (absolutelyEvmTx bool, evmTxAction constants.EvmAction, evmTxSignature string, txValue sdk.Coins, err error) {
@@ -910,19 +1090,25 @@ func (m *Backend) defaultMessageParser(msg sdk.Msg, msgIdx uint, tx *tx.Tx, txRe
 			"sourceChannel":   msg.SourceChannel,
 		}
 
-		if len(msg.Memo) > 0 {
-			res["memo"] = msg.Memo
-		}
-
-		berpctypes.NewFriendlyResponseContentBuilder().
-			WriteAddress(msg.Sender).
+		rb := berpctypes.NewFriendlyResponseContentBuilder()
+		rb.WriteAddress(msg.Sender).
 			WriteText(" transfers ").
 			WriteCoins(sdk.Coins{msg.Token}, m.GetBankDenomsMetadata(sdk.Coins{msg.Token})).
 			WriteText(" to ").
 			WriteAddress(msg.Receiver).
 			WriteText(" through IBC via ").
-			WriteText(msg.SourcePort).WriteText("/").WriteText(msg.SourceChannel).
-			BuildIntoResponse(res)
+			WriteText(msg.SourcePort).WriteText("/").WriteText(msg.SourceChannel)
+
+		if len(msg.Memo) > 0 {
+			res["memo"] = msg.Memo
+
+			if ibcMemo, ok := berpcutils.DecodeIbcMemo(msg.Memo); ok {
+				res["ibcMemo"] = ibcMemoToResponse(ibcMemo)
+				writeIbcMemoFinalReceiver(ibcMemo, rb)
+			}
+		}
+
+		rb.BuildIntoResponse(res)
 
 		return
 	case *connectiontypes.MsgConnectionOpenAck:
@@ -1434,7 +1620,13 @@ func (m *Backend) addIbcPacketInfoIntoResponse(packet channeltypes.Packet, incom
 
 		if data.Memo != "" {
 			res["memo"] = data.Memo
-			rb.WriteText(" with memo ").WriteText(data.Memo)
+
+			if ibcMemo, ok := berpcutils.DecodeIbcMemo(data.Memo); ok {
+				res["ibcMemo"] = ibcMemoToResponse(ibcMemo)
+				writeIbcMemoFinalReceiver(ibcMemo, rb)
+			} else {
+				rb.WriteText(" with memo ").WriteText(data.Memo)
+			}
 		}
 	}
 }
@@ -1510,6 +1702,9 @@ func (m *Backend) defaultMessageInvolversExtractor(msg sdk.Msg, tx *tx.Tx, tmTx
 		return
 	case *ibctransfertypes.MsgTransfer:
 		res.AddGenericInvolvers(berpctypes.MessageInvolvers, msg.Sender, msg.Receiver)
+		if ibcMemo, ok := berpcutils.DecodeIbcMemo(msg.Memo); ok {
+			res.AddGenericInvolvers(berpctypes.MessageInvolvers, ibcMemo.Receivers()...)
+		}
 		return
 	case *connectiontypes.MsgConnectionOpenAck:
 		res.AddGenericInvolvers(berpctypes.MessageInvolvers, msg.Signer)
@@ -1628,11 +1823,71 @@ func getInvolversInIbcPacketInfo(packet channeltypes.Packet) (res berpctypes.Mes
 	var data ibctransfertypes.FungibleTokenPacketData
 	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.Data, &data); err == nil {
 		res.AddGenericInvolvers(berpctypes.MessageInvolvers, data.Sender, data.Receiver)
+		if ibcMemo, ok := berpcutils.DecodeIbcMemo(data.Memo); ok {
+			res.AddGenericInvolvers(berpctypes.MessageInvolvers, ibcMemo.Receivers()...)
+		}
 	}
 
 	return res
 }
 
+// ibcMemoToResponse builds response for decoded memo of packet-forward-middleware and IBC hooks.
+func ibcMemoToResponse(ibcMemo berpcutils.IbcMemo) map[string]any {
+	res := map[string]any{
+		"multiHop":      ibcMemo.IsMultiHop(),
+		"finalReceiver": ibcMemo.FinalReceiver,
+	}
+
+	if ibcMemo.IsMultiHop() {
+		forwards := make([]map[string]any, 0, len(ibcMemo.Forwards))
+		for _, forward := range ibcMemo.Forwards {
+			forwards = append(forwards, map[string]any{
+				"receiver": forward.Receiver,
+				"port":     forward.Port,
+				"channel":  forward.Channel,
+			})
+		}
+		res["forwards"] = forwards
+
+		lastHop := ibcMemo.Forwards[len(ibcMemo.Forwards)-1]
+		res["finalDestination"] = map[string]any{
+			"port":    lastHop.Port,
+			"channel": lastHop.Channel,
+		}
+	}
+
+	if ibcMemo.Hook != nil {
+		hook := map[string]any{
+			"type":     ibcMemo.Hook.Type,
+			"contract": ibcMemo.Hook.Contract,
+		}
+		if ibcMemo.Hook.Msg != nil {
+			hook["msg"] = ibcMemo.Hook.Msg
+		}
+		res["hook"] = hook
+	}
+
+	return res
+}
+
+func writeIbcMemoFinalReceiver(ibcMemo berpcutils.IbcMemo, rb berpctypes.FriendlyResponseContentBuilderI) {
+	if ibcMemo.IsMultiHop() {
+		rb.WriteText(", forwarded through ").
+			WriteText(fmt.Sprintf("%d", len(ibcMemo.Forwards))).
+			WriteText(" hop(s)")
+	}
+
+	if ibcMemo.Hook != nil {
+		rb.WriteText(", executes ").
+			WriteText(ibcMemo.Hook.Type).
+			WriteText(" contract ").
+			WriteAddress(ibcMemo.Hook.Contract)
+	} else {
+		rb.WriteText(", final receiver ").
+			WriteAddress(ibcMemo.FinalReceiver)
+	}
+}
+
 func getTxValueInIbcPacketInfo(packet channeltypes.Packet) sdk.Coin {
 	var data ibctransfertypes.FungibleTokenPacketData
 
@@ -0,0 +1,171 @@
+package backend
+
+import (
+	berpctypes "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/types"
+	berpcutils "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/utils"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/gogo/protobuf/proto"
+)
+
+// addAuthInfoIntoResponse adds the signers, fee, timeout height and tip of the tx into the response.
+func (m *Backend) addAuthInfoIntoResponse(tx *tx.Tx, response berpctypes.GenericBackendResponse) {
+	if tx.AuthInfo == nil {
+		return
+	}
+
+	// signer addresses, ordered as the signer infos, used when public key is not provided
+	var signerAddresses []sdk.AccAddress
+	func() {
+		defer func() {
+			_ = recover() // omit any error
+		}()
+
+		signerAddresses = tx.GetSigners()
+	}()
+
+	signers := make([]map[string]any, 0, len(tx.AuthInfo.SignerInfos))
+	for idx, signerInfo := range tx.AuthInfo.SignerInfos {
+		signerRes := map[string]any{
+			"idx":      idx,
+			"sequence": signerInfo.Sequence,
+		}
+
+		if signerInfo.PublicKey != nil {
+			pubKeyRes := m.pubKeyToResponse(signerInfo.PublicKey)
+			if address, found := pubKeyRes["address"]; found {
+				signerRes["address"] = address
+			}
+			signerRes["pubKey"] = pubKeyRes
+
+			if multiInfo := signerInfo.ModeInfo.GetMulti(); multiInfo != nil {
+				addMultisigSignedIntoResponse(multiInfo, pubKeyRes)
+			}
+		}
+
+		if _, found := signerRes["address"]; !found && idx < len(signerAddresses) {
+			// public key can be omitted when already set in the account state, or not able to be decoded
+			signerRes["address"] = signerAddresses[idx].String()
+		}
+
+		if signerInfo.ModeInfo != nil {
+			signerRes["signMode"] = signModeInfoToResponse(signerInfo.ModeInfo)
+		}
+
+		signers = append(signers, signerRes)
+	}
+	response["signers"] = signers
+
+	if fee := tx.AuthInfo.Fee; fee != nil {
+		feeRes := map[string]any{
+			"amount":   berpcutils.CoinsToMap(fee.Amount...),
+			"gasLimit": fee.GasLimit,
+		}
+
+		func() {
+			defer func() {
+				_ = recover() // omit any error
+			}()
+
+			// fee payer is the first signer if not specified
+			if feePayer := tx.FeePayer(); len(feePayer) > 0 {
+				feeRes["payer"] = feePayer.String()
+			}
+		}()
+
+		if len(fee.Granter) > 0 {
+			feeRes["granter"] = fee.Granter
+		}
+
+		response["fee"] = feeRes
+	}
+
+	if tx.Body.TimeoutHeight > 0 {
+		response["timeoutHeight"] = tx.Body.TimeoutHeight
+	}
+
+	if tip := tx.AuthInfo.Tip; tip != nil {
+		response["tip"] = map[string]any{
+			"amount": berpcutils.CoinsToMap(tip.Amount...),
+			"tipper": tip.Tipper,
+		}
+	}
+}
+
+// pubKeyToResponse decodes the public key, nested public keys of multisig are decoded recursively.
+func (m *Backend) pubKeyToResponse(pubKeyAny *codectypes.Any) map[string]any {
+	res := map[string]any{
+		"type": pubKeyAny.TypeUrl,
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := m.clientCtx.Codec.UnpackAny(pubKeyAny, &pubKey); err != nil {
+		res["decodeError"] = err.Error()
+		return res
+	}
+
+	return m.addPubKeyInfoIntoResponse(pubKey, res)
+}
+
+func (m *Backend) addPubKeyInfoIntoResponse(pubKey cryptotypes.PubKey, res map[string]any) map[string]any {
+	res["address"] = sdk.AccAddress(pubKey.Address()).String()
+
+	if multisigPubKey, isMultisig := pubKey.(multisigtypes.PubKey); isMultisig {
+		res["threshold"] = multisigPubKey.GetThreshold()
+
+		pubKeys := make([]map[string]any, 0)
+		for _, nestedPubKey := range multisigPubKey.GetPubKeys() {
+			pubKeys = append(pubKeys, m.addPubKeyInfoIntoResponse(nestedPubKey, map[string]any{
+				"type": "/" + proto.MessageName(nestedPubKey),
+			}))
+		}
+		res["pubKeys"] = pubKeys
+	} else {
+		res["key"] = pubKey.Bytes()
+	}
+
+	return res
+}
+
+// addMultisigSignedIntoResponse marks which nested public keys of the multisig signed the tx.
+func addMultisigSignedIntoResponse(multiInfo *tx.ModeInfo_Multi, pubKeyRes map[string]any) {
+	if multiInfo.Bitarray == nil {
+		return
+	}
+
+	pubKeys, ok := pubKeyRes["pubKeys"].([]map[string]any)
+	if !ok {
+		return
+	}
+
+	var signedCount int
+	for i, nestedPubKeyRes := range pubKeys {
+		signed := multiInfo.Bitarray.GetIndex(i)
+		nestedPubKeyRes["signed"] = signed
+		if signed {
+			signedCount++
+		}
+	}
+	pubKeyRes["signedCount"] = signedCount
+}
+
+func signModeInfoToResponse(modeInfo *tx.ModeInfo) any {
+	if single := modeInfo.GetSingle(); single != nil {
+		return single.Mode.String()
+	}
+
+	if multi := modeInfo.GetMulti(); multi != nil {
+		modes := make([]any, 0, len(multi.ModeInfos))
+		for _, nestedModeInfo := range multi.ModeInfos {
+			modes = append(modes, signModeInfoToResponse(nestedModeInfo))
+		}
+		return map[string]any{
+			"multi": modes,
+		}
+	}
+
+	return nil
+}
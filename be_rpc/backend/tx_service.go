@@ -0,0 +1,263 @@
+package backend
+
+import (
+	"context"
+	"cosmossdk.io/errors"
+	"encoding/hex"
+	"fmt"
+	"github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/config"
+	berpctypes "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/types"
+	berpcutils "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/utils"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	broadcastModeSync  = "sync"
+	broadcastModeAsync = "async"
+	broadcastModeWait  = "wait"
+)
+
+const (
+	// broadcastTxWaitTimeout is the maximum duration to wait for the tx to be included in a block, on "wait" mode.
+	broadcastTxWaitTimeout = 10 * time.Second
+	// broadcastTxPollInterval is the interval to check if the tx was included in a block, on "wait" mode.
+	broadcastTxPollInterval = time.Second
+)
+
+func (m *Backend) SimulateTransaction(txBytes string, gasAdjustment float64) (berpctypes.GenericBackendResponse, error) {
+	if gasAdjustment < 0 {
+		return nil, status.Error(codes.InvalidArgument, "gas adjustment cannot be negative")
+	}
+	if gasAdjustment == 0 {
+		gasAdjustment = m.cfg.GasAdjustment
+		if gasAdjustment == 0 {
+			gasAdjustment = config.DefaultGasAdjustment
+		}
+	}
+
+	bz, err := berpcutils.DecodeTxBytesString(txBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	decodedTx, err := m.decodeTxBytes(bz)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "failed to decode tx").Error())
+	}
+
+	resSimulate, err := m.queryClient.Simulate(m.ctx, &tx.SimulateRequest{
+		TxBytes: bz,
+	})
+	if err != nil {
+		// errors returned by the application, the tx was rejected by the ante handler or a message handler.
+		// Other errors like node unreachable are not caused by the provided tx.
+		if st, ok := status.FromError(err); ok && (st.Code() == codes.InvalidArgument || st.Code() == codes.Unknown) {
+			return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "failed to simulate tx").Error())
+		}
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to simulate tx").Error())
+	}
+
+	txHash := fmt.Sprintf("%X", tmtypes.Tx(bz).Hash())
+	gasUsed := resSimulate.GasInfo.GasUsed
+	recommendedGas := uint64(math.Ceil(float64(gasUsed) * gasAdjustment))
+
+	// the tx is simulated so there is no real result, only the hash and gas are provided to the message parsers
+	msgsInfo, err := m.parseTransactionMessages(decodedTx, &sdk.TxResponse{
+		TxHash:    txHash,
+		GasWanted: int64(resSimulate.GasInfo.GasWanted),
+		GasUsed:   int64(gasUsed),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var txEvents berpctypes.TxEvents
+	if resSimulate.Result != nil {
+		txEvents = berpctypes.ConvertTxEvent(resSimulate.Result.Events).RemoveUnnecessaryEvmTxEvents()
+	}
+
+	gasInfo := berpctypes.GenericBackendResponse{
+		"used":          gasUsed,
+		"adjustment":    gasAdjustment,
+		"recommended":   recommendedGas,
+		"providedLimit": resSimulate.GasInfo.GasWanted,
+	}
+
+	response := berpctypes.GenericBackendResponse{
+		"hash":   txHash,
+		"msgs":   msgsInfo,
+		"gas":    gasInfo,
+		"events": txEvents,
+	}
+
+	resNodeConfig, err := m.queryClient.NodeQueryClient.Config(m.ctx, &nodeservice.ConfigRequest{})
+	if err != nil {
+		m.GetLogger().Error("failed to get node config", "error", err)
+	} else {
+		minGasPrices, err := sdk.ParseDecCoins(resNodeConfig.MinimumGasPrice)
+		if err != nil {
+			m.GetLogger().Error("failed to parse minimum gas prices", "minimum-gas-prices", resNodeConfig.MinimumGasPrice, "error", err)
+		} else {
+			response["minGasPrices"] = minGasPrices.String()
+			// each denom is an alternative to pay the fee
+			response["estimatedFee"] = berpcutils.CoinsToMap(estimateFeeByMinGasPrices(minGasPrices, recommendedGas)...)
+		}
+	}
+
+	return response, nil
+}
+
+// estimateFeeByMinGasPrices returns the minimum fee required for the given gas limit, for each denom of the gas prices.
+func estimateFeeByMinGasPrices(minGasPrices sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	fees := make(sdk.Coins, 0, len(minGasPrices))
+	gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasLimit))
+	for _, gasPrice := range minGasPrices {
+		fee := gasPrice.Amount.Mul(gas).Ceil().RoundInt()
+		if fee.IsPositive() {
+			fees = append(fees, sdk.NewCoin(gasPrice.Denom, fee))
+		}
+	}
+	return fees.Sort()
+}
+
+func (m *Backend) BroadcastTransaction(txBytes string, mode string) (berpctypes.GenericBackendResponse, error) {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+
+	var broadcastMode tx.BroadcastMode
+	switch mode {
+	case "", broadcastModeSync:
+		mode = broadcastModeSync
+		broadcastMode = tx.BroadcastMode_BROADCAST_MODE_SYNC
+	case broadcastModeAsync:
+		broadcastMode = tx.BroadcastMode_BROADCAST_MODE_ASYNC
+	case broadcastModeWait:
+		broadcastMode = tx.BroadcastMode_BROADCAST_MODE_SYNC
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid broadcast mode %s, expected: %s, %s or %s", mode, broadcastModeSync, broadcastModeAsync, broadcastModeWait))
+	}
+
+	bz, err := berpcutils.DecodeTxBytesString(txBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resBroadcast, err := m.queryClient.BroadcastTx(m.ctx, &tx.BroadcastTxRequest{
+		TxBytes: bz,
+		Mode:    broadcastMode,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to broadcast tx").Error())
+	}
+
+	txRes := resBroadcast.TxResponse
+	response := berpctypes.GenericBackendResponse{
+		"hash": txRes.TxHash,
+		"mode": mode,
+	}
+
+	if mode == broadcastModeAsync {
+		return response, nil
+	}
+
+	response["checkTx"] = berpctypes.GenericBackendResponse{
+		"code":      txRes.Code,
+		"codespace": txRes.Codespace,
+		"success":   txRes.Code == 0,
+		"log":       txRes.RawLog,
+		"gas": berpctypes.GenericBackendResponse{
+			"limit": txRes.GasWanted,
+			"used":  txRes.GasUsed,
+		},
+	}
+
+	if mode != broadcastModeWait || txRes.Code != 0 {
+		return response, nil
+	}
+
+	resTx, err := m.waitForTxInclusion(txRes.TxHash)
+	if err != nil {
+		// the tx was accepted into the mempool, so error is reported as part of the response,
+		// to prevent client from considering the broadcast as failed and re-broadcasting.
+		m.GetLogger().Error("failed to wait for tx inclusion", "hash", txRes.TxHash, "error", err)
+		response["included"] = false
+		response["waitError"] = err.Error()
+		return response, nil
+	}
+
+	response["included"] = resTx != nil
+	if resTx == nil {
+		return response, nil
+	}
+
+	response["height"] = resTx.Height
+	response["deliverTx"] = berpctypes.GenericBackendResponse{
+		"code":      resTx.TxResult.Code,
+		"codespace": resTx.TxResult.Codespace,
+		"success":   resTx.TxResult.Code == 0,
+	}
+
+	txByHash, err := m.GetTransactionByHash(txRes.TxHash)
+	if err != nil {
+		// the tx was already included, lookup failure must not be reported as failure of the broadcast
+		m.GetLogger().Error("failed to get included tx", "hash", txRes.TxHash, "error", err)
+		response["txError"] = err.Error()
+		return response, nil
+	}
+	response["tx"] = txByHash
+
+	return response, nil
+}
+
+// waitForTxInclusion polls the tx index until the tx was included in a block or the timeout elapsed.
+// Returns nil if the tx was not included within the timeout.
+func (m *Backend) waitForTxInclusion(txHash string) (*coretypes.ResultTx, error) {
+	hashBz, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to decode tx hash").Error())
+	}
+
+	timeout := broadcastTxWaitTimeout
+	if m.cfg.HTTPTimeout > 0 && timeout >= m.cfg.HTTPTimeout {
+		// response must be returned before the http server timed out
+		timeout = m.cfg.HTTPTimeout / 2
+	}
+
+	ctx, cancel := context.WithTimeout(m.ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(broadcastTxPollInterval)
+	defer ticker.Stop()
+
+	// searching the tx index returns an empty result when the tx is not found,
+	// instead of an error which can not be distinguished from other failures
+	txSearchQuery := fmt.Sprintf("%s='%X'", tmtypes.TxHashKey, hashBz)
+	var page = 1
+	var perPage = 1
+	for {
+		resTxSearch, err := m.clientCtx.Client.TxSearch(ctx, txSearchQuery, false, &page, &perPage, "asc")
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil
+			}
+			return nil, status.Error(codes.Internal, errors.Wrap(err, "failed to search tx").Error())
+		}
+		if len(resTxSearch.Txs) > 0 {
+			return resTxSearch.Txs[0], nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -7,9 +7,28 @@ import (
 const defaultPageSize = 20
 
 func getDefaultPagination(pageNo int) *query.PageRequest {
+	return getPagination(pageNo, defaultPageSize)
+}
+
+func getPagination(pageNo, pageSize int) *query.PageRequest {
 	return &query.PageRequest{
-		Offset:  uint64(defaultPageSize * (pageNo - 1)),
-		Limit:   defaultPageSize,
+		Offset:  uint64(pageSize * (pageNo - 1)),
+		Limit:   uint64(pageSize),
 		Reverse: true,
 	}
 }
+
+// getPageRange returns the index range [from, to) of the given page number, bounded by the total number of items.
+func getPageRange(pageNo, pageSize, total int) (from, to int) {
+	from = (pageNo - 1) * pageSize
+	if from > total {
+		from = total
+	}
+
+	to = from + pageSize
+	if to > total {
+		to = total
+	}
+
+	return
+}
@@ -24,6 +24,8 @@ type BeJsonRpcConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// AllowCORS defines if the server should allow CORS requests. Allowed by default.
 	AllowCORS bool `mapstructure:"allow-cors"`
+	// GasAdjustment is the multiplier applied to the simulated gas used, to recommend gas limit for transactions.
+	GasAdjustment float64 `mapstructure:"gas-adjustment"`
 }
 
 // DefaultBeJsonRpcConfig returns Block Explorer JSON-RPC API config with default values
@@ -35,6 +37,7 @@ func DefaultBeJsonRpcConfig() *BeJsonRpcConfig {
 		HTTPIdleTimeout:    DefaultHTTPIdleTimeout,
 		MaxOpenConnections: DefaultMaxOpenConnections,
 		AllowCORS:          DefaultAllowCORS,
+		GasAdjustment:      DefaultGasAdjustment,
 	}
 }
 
@@ -48,6 +51,10 @@ func (c BeJsonRpcConfig) Validate() error {
 		return errors.New("BE-JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.GasAdjustment < 0 {
+		return errors.New("BE-JSON-RPC gas adjustment cannot be negative")
+	}
+
 	return nil
 }
 
@@ -60,6 +67,7 @@ func GetConfig(v *viper.Viper) (BeJsonRpcConfig, error) {
 		HTTPIdleTimeout:    v.GetDuration(FlagBeJsonRpcHttpIdleTimeout),
 		MaxOpenConnections: v.GetInt(FlagBeJsonRpcMaxOpenConnection),
 		AllowCORS:          v.GetBool(FlagBeJsonRpcAllowCORS),
+		GasAdjustment:      v.GetFloat64(FlagBeJsonRpcGasAdjustment),
 	}
 
 	return cfg, cfg.Validate()
@@ -74,6 +82,7 @@ func AddBeJsonRpcFlags(cmd *cobra.Command) {
 	cmd.Flags().Duration(FlagBeJsonRpcHttpIdleTimeout, DefaultHTTPIdleTimeout, "sets an idle timeout for Block Explorer Json-RPC http server (0 is no timeout)")
 	cmd.Flags().Duration(FlagBeJsonRpcMaxOpenConnection, DefaultMaxOpenConnections, "sets maximum open connection for Block Explorer Json-RPC http server (0 is unlimited)")
 	cmd.Flags().Bool(FlagBeJsonRpcAllowCORS, DefaultAllowCORS, "define if the Block Explorer Json-RPC should allow CORS requests")
+	cmd.Flags().Float64(FlagBeJsonRpcGasAdjustment, DefaultGasAdjustment, "sets the multiplier applied to the simulated gas used, to recommend gas limit for transactions")
 }
 
 // GetViperConfig reads configuration parameters from Viper instance.
@@ -13,6 +13,7 @@ const (
 	FlagBeJsonRpcHttpIdleTimeout   = "be.http-idle-timeout"
 	FlagBeJsonRpcMaxOpenConnection = "be.max-open-connections"
 	FlagBeJsonRpcAllowCORS         = "be.allow-cors"
+	FlagBeJsonRpcGasAdjustment     = "be.gas-adjustment"
 )
 
 const (
@@ -33,6 +34,9 @@ const (
 
 	// DefaultAllowCORS represents the default value for allowing CORS requests
 	DefaultAllowCORS = true
+
+	// DefaultGasAdjustment is the default multiplier applied to the simulated gas used
+	DefaultGasAdjustment = 1.3
 )
 
 func bindFlagsToViper(cmd *cobra.Command, v *viper.Viper) error {
@@ -54,5 +58,8 @@ func bindFlagsToViper(cmd *cobra.Command, v *viper.Viper) error {
 	if err := v.BindPFlag("allow-cors", cmd.Flags().Lookup(FlagBeJsonRpcAllowCORS)); err != nil {
 		return err
 	}
+	if err := v.BindPFlag("gas-adjustment", cmd.Flags().Lookup(FlagBeJsonRpcGasAdjustment)); err != nil {
+		return err
+	}
 	return nil
 }
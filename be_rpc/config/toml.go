@@ -88,4 +88,7 @@ max-open-connections = {{ .MaxOpenConnections }}
 # defines if the server should allow CORS requests.
 allow-cors = {{ .AllowCORS }}
 
+# multiplier applied to the simulated gas used, to recommend gas limit for transactions.
+gas-adjustment = {{ .GasAdjustment }}
+
 `
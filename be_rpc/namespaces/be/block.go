@@ -25,7 +25,31 @@ func (api *API) GetRecentBlocks(pageNoOptional, pageSizeOptional *int) (berpctyp
 	return api.backend.GetRecentBlocks(pageNo, pageSize)
 }
 
-func (api *API) GetBlockByNumber(height int64) (berpctypes.GenericBackendResponse, error) {
+func (api *API) GetBlockByNumber(height int64, detailedOptional *bool) (berpctypes.GenericBackendResponse, error) {
 	api.logger.Debug("be_getBlockByNumber")
-	return api.backend.GetBlockByNumber(height)
+
+	detailed := detailedOptional != nil && *detailedOptional
+
+	return api.backend.GetBlockByNumber(height, detailed)
+}
+
+func (api *API) GetBlockByHash(hash string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getBlockByHash")
+	return api.backend.GetBlockByHash(hash)
+}
+
+func (api *API) GetBlockByTime(epochUTC int64, directionOptional *string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getBlockByTime")
+
+	var direction string
+	if directionOptional != nil {
+		direction = *directionOptional
+	}
+
+	return api.backend.GetBlockByTime(epochUTC, direction)
+}
+
+func (api *API) GetBlockEvents(height int64) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getBlockEvents")
+	return api.backend.GetBlockEvents(height)
 }
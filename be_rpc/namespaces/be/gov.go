@@ -9,7 +9,7 @@ func (api *API) GetGovProposal(proposal uint64) (berpctypes.GenericBackendRespon
 	return api.backend.GetGovProposal(proposal)
 }
 
-func (api *API) GetGovProposals(pageNoOptional *int) (berpctypes.GenericBackendResponse, error) {
+func (api *API) GetGovProposals(pageNoOptional, pageSizeOptional *int, filterOptional *berpctypes.GovProposalsFilter) (berpctypes.GenericBackendResponse, error) {
 	api.logger.Debug("be_getGovProposals")
 
 	pageNo, err := getPageNumber(pageNoOptional)
@@ -17,5 +17,47 @@ func (api *API) GetGovProposals(pageNoOptional *int) (berpctypes.GenericBackendR
 		return nil, err
 	}
 
-	return api.backend.GetGovProposals(pageNo)
+	var pageSize int // default will be applied by backend
+	if pageSizeOptional != nil {
+		pageSize = *pageSizeOptional
+	}
+
+	var filter berpctypes.GovProposalsFilter
+	if filterOptional != nil {
+		filter = *filterOptional
+	}
+
+	return api.backend.GetGovProposals(pageNo, pageSize, filter)
+}
+
+func (api *API) GetGovProposalVotes(proposal uint64, pageNoOptional *int, optionOptional *string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getGovProposalVotes")
+
+	pageNo, err := getPageNumber(pageNoOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	var option string
+	if optionOptional != nil {
+		option = *optionOptional
+	}
+
+	return api.backend.GetGovProposalVotes(proposal, pageNo, option)
+}
+
+func (api *API) GetGovProposalDeposits(proposal uint64, pageNoOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getGovProposalDeposits")
+
+	pageNo, err := getPageNumber(pageNoOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.backend.GetGovProposalDeposits(proposal, pageNo)
+}
+
+func (api *API) GetValidatorGovParticipation(valAddr string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getValidatorGovParticipation")
+	return api.backend.GetValidatorGovParticipation(valAddr)
 }
@@ -0,0 +1,63 @@
+package be
+
+import berpctypes "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/types"
+
+func (api *API) GetIbcChannels(pageNoOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getIbcChannels")
+
+	pageNo, err := getPageNumber(pageNoOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.backend.GetIbcChannels(pageNo)
+}
+
+func (api *API) GetIbcChannel(port, channel string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getIbcChannel")
+	return api.backend.GetIbcChannel(port, channel)
+}
+
+func (api *API) GetIbcConnections(pageNoOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getIbcConnections")
+
+	pageNo, err := getPageNumber(pageNoOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.backend.GetIbcConnections(pageNo)
+}
+
+func (api *API) GetIbcClients(pageNoOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getIbcClients")
+
+	pageNo, err := getPageNumber(pageNoOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.backend.GetIbcClients(pageNo)
+}
+
+func (api *API) GetIbcPacket(srcPort, srcChannel string, sequence uint64, dstPortOptional, dstChannelOptional, directionOptional *string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getIbcPacket")
+
+	var dstPort, dstChannel, direction string
+	if dstPortOptional != nil {
+		dstPort = *dstPortOptional
+	}
+	if dstChannelOptional != nil {
+		dstChannel = *dstChannelOptional
+	}
+	if directionOptional != nil {
+		direction = *directionOptional
+	}
+
+	return api.backend.GetIbcPacket(srcPort, srcChannel, sequence, dstPort, dstChannel, direction)
+}
+
+func (api *API) GetIbcChannelBacklog(port, channel string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getIbcChannelBacklog")
+	return api.backend.GetIbcChannelBacklog(port, channel)
+}
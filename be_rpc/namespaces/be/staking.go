@@ -7,7 +7,86 @@ func (api *API) GetStakingInfo(delegatorAddr string) (berpctypes.GenericBackendR
 	return api.backend.GetStakingInfo(delegatorAddr)
 }
 
-func (api *API) GetValidators() (berpctypes.GenericBackendResponse, error) {
+func (api *API) GetDelegatorStakingDetails(delegatorAddr string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getDelegatorStakingDetails")
+	return api.backend.GetDelegatorStakingDetails(delegatorAddr)
+}
+
+func (api *API) GetValidators(statusFilterOptional, sortByOptional *string) (berpctypes.GenericBackendResponse, error) {
 	api.logger.Debug("be_getValidators")
-	return api.backend.GetValidators()
+
+	var statusFilter, sortBy string
+	if statusFilterOptional != nil {
+		statusFilter = *statusFilterOptional
+	}
+	if sortByOptional != nil {
+		sortBy = *sortByOptional
+	}
+
+	return api.backend.GetValidators(statusFilter, sortBy)
+}
+
+func (api *API) GetValidatorUptime(consOrValAddr string, windowOptional *int64) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getValidatorUptime")
+
+	var window int64 // default will be applied by backend
+	if windowOptional != nil {
+		window = *windowOptional
+	}
+
+	return api.backend.GetValidatorUptime(consOrValAddr, window)
+}
+
+func (api *API) GetValidatorDelegations(valAddr string, pageNoOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getValidatorDelegations")
+
+	pageNo, err := getPageNumber(pageNoOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.backend.GetValidatorDelegations(valAddr, pageNo)
+}
+
+func (api *API) GetValidatorUnbondingDelegations(valAddr string, pageNoOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getValidatorUnbondingDelegations")
+
+	pageNo, err := getPageNumber(pageNoOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.backend.GetValidatorUnbondingDelegations(valAddr, pageNo)
+}
+
+func (api *API) GetValidatorRedelegations(valAddr string, pageNoOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getValidatorRedelegations")
+
+	pageNo, err := getPageNumber(pageNoOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.backend.GetValidatorRedelegations(valAddr, pageNo)
+}
+
+func (api *API) GetStakingEconomics() (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getStakingEconomics")
+	return api.backend.GetStakingEconomics()
+}
+
+func (api *API) GetVotingPowerDistribution(heightOptional *int64, topNOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getVotingPowerDistribution")
+
+	var height int64
+	if heightOptional != nil {
+		height = *heightOptional
+	}
+
+	var topN int // default will be applied by backend
+	if topNOptional != nil {
+		topN = *topNOptional
+	}
+
+	return api.backend.GetVotingPowerDistribution(height, topN)
 }
@@ -18,3 +18,46 @@ func (api *API) GetTransactionByHash(hash string) (berpctypes.GenericBackendResp
 	api.logger.Debug("be_getTransactionByHash")
 	return api.backend.GetTransactionByHash(hash)
 }
+
+func (api *API) GetPendingTransactions(limitOptional *int) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getPendingTransactions")
+
+	var limit int // default will be applied by backend
+	if limitOptional != nil {
+		limit = *limitOptional
+	}
+
+	return api.backend.GetPendingTransactions(limit)
+}
+
+func (api *API) GetPendingTransaction(hash string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_getPendingTransaction")
+	return api.backend.GetPendingTransaction(hash)
+}
+
+func (api *API) DecodeTransaction(txBytes string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_decodeTransaction")
+	return api.backend.DecodeTransaction(txBytes)
+}
+
+func (api *API) SimulateTransaction(txBytes string, gasAdjustmentOptional *float64) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_simulateTransaction")
+
+	var gasAdjustment float64
+	if gasAdjustmentOptional != nil {
+		gasAdjustment = *gasAdjustmentOptional
+	}
+
+	return api.backend.SimulateTransaction(txBytes, gasAdjustment)
+}
+
+func (api *API) BroadcastTransaction(txBytes string, modeOptional *string) (berpctypes.GenericBackendResponse, error) {
+	api.logger.Debug("be_broadcastTransaction")
+
+	var mode string
+	if modeOptional != nil {
+		mode = *modeOptional
+	}
+
+	return api.backend.BroadcastTransaction(txBytes, mode)
+}
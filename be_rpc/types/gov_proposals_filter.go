@@ -0,0 +1,13 @@
+package types
+
+// GovProposalsFilter is the optional filter for querying gov proposals.
+type GovProposalsFilter struct {
+	// Status is the proposal status: deposit, voting, passed, rejected, failed.
+	Status string `json:"status,omitempty"`
+	// Proposer is the address of the account that submitted the proposal.
+	Proposer string `json:"proposer,omitempty"`
+	// Depositor is the address of the account that deposited to the proposal.
+	Depositor string `json:"depositor,omitempty"`
+	// Voter is the address of the account that voted on the proposal.
+	Voter string `json:"voter,omitempty"`
+}
@@ -1,14 +1,20 @@
 package types
 
 import (
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 )
@@ -18,25 +24,37 @@ import (
 type QueryClient struct {
 	tx.ServiceClient
 
-	BankQueryClient         banktypes.QueryClient
-	StakingQueryClient      stakingtypes.QueryClient
-	DistributionQueryClient disttypes.QueryClient
-	GovV1QueryClient        govv1types.QueryClient
-	MintQueryClient         minttypes.QueryClient
-	AuthQueryClient         authtypes.QueryClient
-	IbcTransferQueryClient  ibctransfertypes.QueryClient
+	BankQueryClient          banktypes.QueryClient
+	StakingQueryClient       stakingtypes.QueryClient
+	DistributionQueryClient  disttypes.QueryClient
+	GovV1QueryClient         govv1types.QueryClient
+	GovV1Beta1QueryClient    govv1beta1types.QueryClient
+	MintQueryClient          minttypes.QueryClient
+	SlashingQueryClient      slashingtypes.QueryClient
+	AuthQueryClient          authtypes.QueryClient
+	IbcTransferQueryClient   ibctransfertypes.QueryClient
+	IbcClientQueryClient     ibctypes.QueryClient
+	IbcConnectionQueryClient connectiontypes.QueryClient
+	IbcChannelQueryClient    channeltypes.QueryClient
+	NodeQueryClient          nodeservice.ServiceClient
 }
 
 // NewQueryClient creates a new gRPC query client
 func NewQueryClient(clientCtx client.Context) *QueryClient {
 	return &QueryClient{
-		ServiceClient:           tx.NewServiceClient(clientCtx),
-		BankQueryClient:         banktypes.NewQueryClient(clientCtx),
-		StakingQueryClient:      stakingtypes.NewQueryClient(clientCtx),
-		DistributionQueryClient: disttypes.NewQueryClient(clientCtx),
-		GovV1QueryClient:        govv1types.NewQueryClient(clientCtx),
-		MintQueryClient:         minttypes.NewQueryClient(clientCtx),
-		AuthQueryClient:         authtypes.NewQueryClient(clientCtx),
-		IbcTransferQueryClient:  ibctransfertypes.NewQueryClient(clientCtx),
+		ServiceClient:            tx.NewServiceClient(clientCtx),
+		BankQueryClient:          banktypes.NewQueryClient(clientCtx),
+		StakingQueryClient:       stakingtypes.NewQueryClient(clientCtx),
+		DistributionQueryClient:  disttypes.NewQueryClient(clientCtx),
+		GovV1QueryClient:         govv1types.NewQueryClient(clientCtx),
+		GovV1Beta1QueryClient:    govv1beta1types.NewQueryClient(clientCtx),
+		MintQueryClient:          minttypes.NewQueryClient(clientCtx),
+		SlashingQueryClient:      slashingtypes.NewQueryClient(clientCtx),
+		AuthQueryClient:          authtypes.NewQueryClient(clientCtx),
+		IbcTransferQueryClient:   ibctransfertypes.NewQueryClient(clientCtx),
+		IbcClientQueryClient:     ibctypes.NewQueryClient(clientCtx),
+		IbcConnectionQueryClient: connectiontypes.NewQueryClient(clientCtx),
+		IbcChannelQueryClient:    channeltypes.NewQueryClient(clientCtx),
+		NodeQueryClient:          nodeservice.NewServiceClient(clientCtx),
 	}
 }
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BalanceChanges holds the net balance changes by address and denom, negative amount means balance decreased.
+type BalanceChanges map[string]map[string]sdk.Int
+
+// GetBalanceChangesFromEvents computes the net balance changes by address and denom,
+// from the bank `coin_spent` and `coin_received` events.
+// Addresses and denoms those have zero net change are omitted.
+func GetBalanceChangesFromEvents(events []abci.Event) (BalanceChanges, error) {
+	changes := make(BalanceChanges)
+
+	for _, event := range events {
+		var addressKey string
+		var sign int64
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addressKey = banktypes.AttributeKeySpender
+			sign = -1
+		case banktypes.EventTypeCoinReceived:
+			addressKey = banktypes.AttributeKeyReceiver
+			sign = 1
+		default:
+			continue
+		}
+
+		// attributes are paired by order of appearance, in case multiple events were merged into one
+		var address string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case addressKey:
+				address = string(attr.Value)
+			case sdk.AttributeKeyAmount:
+				if address == "" {
+					return nil, fmt.Errorf("missing %s before %s in event %s", addressKey, sdk.AttributeKeyAmount, event.Type)
+				}
+
+				coins, err := sdk.ParseCoinsNormalized(string(attr.Value))
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse amount %s in event %s: %w", string(attr.Value), event.Type, err)
+				}
+
+				changes.add(address, coins, sign)
+				address = ""
+			}
+		}
+	}
+
+	changes.removeZero()
+
+	return changes, nil
+}
+
+func (bc BalanceChanges) add(address string, coins sdk.Coins, sign int64) {
+	byDenom, found := bc[address]
+	if !found {
+		byDenom = make(map[string]sdk.Int)
+		bc[address] = byDenom
+	}
+
+	for _, coin := range coins {
+		amount := coin.Amount.MulRaw(sign)
+		if existing, found := byDenom[coin.Denom]; found {
+			amount = existing.Add(amount)
+		}
+		byDenom[coin.Denom] = amount
+	}
+}
+
+func (bc BalanceChanges) removeZero() {
+	for address, byDenom := range bc {
+		for denom, amount := range byDenom {
+			if amount.IsZero() {
+				delete(byDenom, denom)
+			}
+		}
+		if len(byDenom) == 0 {
+			delete(bc, address)
+		}
+	}
+}
+
+// ToResponse converts the balance changes into response format, amounts are represented as string.
+func (bc BalanceChanges) ToResponse() map[string]map[string]string {
+	res := make(map[string]map[string]string)
+	for address, byDenom := range bc {
+		res[address] = make(map[string]string)
+		for denom, amount := range byDenom {
+			res[address][denom] = amount.String()
+		}
+	}
+	return res
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"testing"
+)
+
+func newTestBankEvent(eventType string, kvs ...string) abci.Event {
+	event := abci.Event{
+		Type: eventType,
+	}
+	for i := 0; i < len(kvs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key:   []byte(kvs[i]),
+			Value: []byte(kvs[i+1]),
+		})
+	}
+	return event
+}
+
+func TestGetBalanceChangesFromEvents(t *testing.T) {
+	testcases := []struct {
+		name    string
+		events  []abci.Event
+		want    map[string]map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "no events",
+			events: nil,
+			want:   map[string]map[string]string{},
+		},
+		{
+			name: "fee deduction and transfer",
+			events: []abci.Event{
+				newTestBankEvent("coin_spent", "spender", "alice", "amount", "10stake"),
+				newTestBankEvent("coin_received", "receiver", "fee_collector", "amount", "10stake"),
+				newTestBankEvent("message", "action", "/cosmos.bank.v1beta1.MsgSend"),
+				newTestBankEvent("coin_spent", "spender", "alice", "amount", "100stake,5ibc/ABC"),
+				newTestBankEvent("coin_received", "receiver", "bob", "amount", "100stake,5ibc/ABC"),
+			},
+			want: map[string]map[string]string{
+				"alice":         {"stake": "-110", "ibc/ABC": "-5"},
+				"fee_collector": {"stake": "10"},
+				"bob":           {"stake": "100", "ibc/ABC": "5"},
+			},
+		},
+		{
+			name: "net zero changes are omitted",
+			events: []abci.Event{
+				newTestBankEvent("coin_spent", "spender", "alice", "amount", "10stake"),
+				newTestBankEvent("coin_received", "receiver", "alice", "amount", "10stake,1atom"),
+			},
+			want: map[string]map[string]string{
+				"alice": {"atom": "1"},
+			},
+		},
+		{
+			name: "merged attributes are paired by order",
+			events: []abci.Event{
+				newTestBankEvent("coin_received", "receiver", "alice", "amount", "1stake", "receiver", "bob", "amount", "2stake"),
+			},
+			want: map[string]map[string]string{
+				"alice": {"stake": "1"},
+				"bob":   {"stake": "2"},
+			},
+		},
+		{
+			name: "empty amount",
+			events: []abci.Event{
+				newTestBankEvent("coin_received", "receiver", "alice", "amount", ""),
+			},
+			want: map[string]map[string]string{},
+		},
+		{
+			name: "invalid amount",
+			events: []abci.Event{
+				newTestBankEvent("coin_spent", "spender", "alice", "amount", "invalid"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing spender",
+			events: []abci.Event{
+				newTestBankEvent("coin_spent", "amount", "10stake"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetBalanceChangesFromEvents(tt.events)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.ToResponse())
+		})
+	}
+}
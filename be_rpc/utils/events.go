@@ -1,7 +1,11 @@
 package utils
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"regexp"
+	"strings"
 )
 
 func IsEventTypeWithAllAttributes(event abci.Event, eventType string, attributeKeys ...string) (bool, map[string]string) {
@@ -25,3 +29,81 @@ func IsEventTypeWithAllAttributes(event abci.Event, eventType string, attributeK
 
 	return true, keyToValue
 }
+
+// ParseEventAmount parses the amount attribute of an event, which can be either coins, dec coins
+// or a bare integer without denom (like the mint event), in that case the given default denom is used.
+// Dec coins are truncated. Returns empty coins if unable to parse.
+func ParseEventAmount(amount string, defaultDenom string) sdk.Coins {
+	if amount == "" {
+		return sdk.Coins{}
+	}
+
+	if coins, err := sdk.ParseCoinsNormalized(amount); err == nil {
+		return coins
+	}
+
+	if decCoins, err := sdk.ParseDecCoins(amount); err == nil {
+		coins, _ := decCoins.TruncateDecimal()
+		return coins
+	}
+
+	if defaultDenom != "" {
+		if bareAmount, ok := sdk.NewIntFromString(amount); ok && !bareAmount.IsNegative() {
+			return sdk.NewCoins(sdk.NewCoin(defaultDenom, bareAmount))
+		}
+	}
+
+	return sdk.Coins{}
+}
+
+// patternVoteOptionOfEvent matches each option and weight (optional) of the weighted vote options,
+// formatted as text (option:VOTE_OPTION_YES weight:"1.0"), yaml or JSON.
+var patternVoteOptionOfEvent = regexp.MustCompile(`(VOTE_OPTION_[A-Z_]+)(?:[\s,"]*weight["\s:]*"?([\d.]+))?`)
+
+// legacyVoteOptions are the vote option names emitted by legacy versions of gov module, before weighted vote.
+var legacyVoteOptions = map[string]govv1types.VoteOption{
+	"Yes":        govv1types.OptionYes,
+	"Abstain":    govv1types.OptionAbstain,
+	"No":         govv1types.OptionNo,
+	"NoWithVeto": govv1types.OptionNoWithVeto,
+}
+
+// ParseVoteOptionsFromEvent parses the weighted vote options from the option attribute of the proposal_vote event.
+// Weight is 1 if not provided. Returns false if unable to parse.
+func ParseVoteOptionsFromEvent(option string) (govv1types.WeightedVoteOptions, bool) {
+	option = strings.TrimSpace(option)
+	if option == "" {
+		return nil, false
+	}
+
+	if legacyOption, found := legacyVoteOptions[option]; found {
+		return govv1types.WeightedVoteOptions{
+			govv1types.NewWeightedVoteOption(legacyOption, sdk.OneDec()),
+		}, true
+	}
+
+	matches := patternVoteOptionOfEvent.FindAllStringSubmatch(option, -1)
+	if len(matches) < 1 {
+		return nil, false
+	}
+
+	options := make(govv1types.WeightedVoteOptions, 0, len(matches))
+	for _, match := range matches {
+		voteOption, err := govv1types.VoteOptionFromString(match[1])
+		if err != nil {
+			return nil, false
+		}
+
+		weight := sdk.OneDec()
+		if match[2] != "" {
+			weight, err = sdk.NewDecFromStr(match[2])
+			if err != nil {
+				return nil, false
+			}
+		}
+
+		options = append(options, govv1types.NewWeightedVoteOption(voteOption, weight))
+	}
+
+	return options, true
+}
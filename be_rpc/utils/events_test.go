@@ -1,6 +1,8 @@
 package utils
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"testing"
@@ -58,3 +60,145 @@ func TestIsEventTypeWithAllAttributes(t *testing.T) {
 	}, "test", "key3", "key4")
 	require.False(t, ok)
 }
+
+func TestParseEventAmount(t *testing.T) {
+	tests := []struct {
+		name         string
+		amount       string
+		defaultDenom string
+		want         sdk.Coins
+	}{
+		{
+			name:   "empty",
+			amount: "",
+			want:   sdk.Coins{},
+		},
+		{
+			name:   "coins",
+			amount: "100stake,5ibc/ABC",
+			want:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ibc/ABC", 5)),
+		},
+		{
+			name:   "dec coins are truncated",
+			amount: "100.9stake",
+			want:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		},
+		{
+			name:         "bare integer of mint event, with default denom",
+			amount:       "4273972602",
+			defaultDenom: "stake",
+			want:         sdk.NewCoins(sdk.NewInt64Coin("stake", 4273972602)),
+		},
+		{
+			name:   "bare integer without default denom",
+			amount: "4273972602",
+			want:   sdk.Coins{},
+		},
+		{
+			name:         "coins are not overridden by default denom",
+			amount:       "100uatom",
+			defaultDenom: "stake",
+			want:         sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+		},
+		{
+			name:         "invalid",
+			amount:       "invalid",
+			defaultDenom: "stake",
+			want:         sdk.Coins{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ParseEventAmount(tt.amount, tt.defaultDenom))
+		})
+	}
+}
+
+func TestParseVoteOptionsFromEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		option string
+		want   govv1types.WeightedVoteOptions
+		wantOk bool
+	}{
+		{
+			name: "single option, as emitted by gov v1",
+			option: govv1types.WeightedVoteOptions{
+				govv1types.NewWeightedVoteOption(govv1types.OptionYes, sdk.OneDec()),
+			}.String(),
+			want: govv1types.WeightedVoteOptions{
+				govv1types.NewWeightedVoteOption(govv1types.OptionYes, sdk.OneDec()),
+			},
+			wantOk: true,
+		},
+		{
+			name: "weighted options, as emitted by gov v1",
+			option: govv1types.WeightedVoteOptions{
+				govv1types.NewWeightedVoteOption(govv1types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+				govv1types.NewWeightedVoteOption(govv1types.OptionNoWithVeto, sdk.NewDecWithPrec(3, 1)),
+			}.String(),
+			want: govv1types.WeightedVoteOptions{
+				govv1types.NewWeightedVoteOption(govv1types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+				govv1types.NewWeightedVoteOption(govv1types.OptionNoWithVeto, sdk.NewDecWithPrec(3, 1)),
+			},
+			wantOk: true,
+		},
+		{
+			name:   "yaml",
+			option: "option: VOTE_OPTION_NO\nweight: \"1.000000000000000000\"",
+			want: govv1types.WeightedVoteOptions{
+				govv1types.NewWeightedVoteOption(govv1types.OptionNo, sdk.OneDec()),
+			},
+			wantOk: true,
+		},
+		{
+			name:   "JSON",
+			option: `[{"option":"VOTE_OPTION_ABSTAIN","weight":"0.5"},{"option":"VOTE_OPTION_NO","weight":"0.5"}]`,
+			want: govv1types.WeightedVoteOptions{
+				govv1types.NewWeightedVoteOption(govv1types.OptionAbstain, sdk.NewDecWithPrec(5, 1)),
+				govv1types.NewWeightedVoteOption(govv1types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+			},
+			wantOk: true,
+		},
+		{
+			name:   "enum name without weight",
+			option: "VOTE_OPTION_YES",
+			want: govv1types.WeightedVoteOptions{
+				govv1types.NewWeightedVoteOption(govv1types.OptionYes, sdk.OneDec()),
+			},
+			wantOk: true,
+		},
+		{
+			name:   "legacy option name",
+			option: "NoWithVeto",
+			want: govv1types.WeightedVoteOptions{
+				govv1types.NewWeightedVoteOption(govv1types.OptionNoWithVeto, sdk.OneDec()),
+			},
+			wantOk: true,
+		},
+		{
+			name:   "unknown option",
+			option: "VOTE_OPTION_MAYBE",
+			wantOk: false,
+		},
+		{
+			name:   "empty",
+			option: "",
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseVoteOptionsFromEvent(tt.option)
+			require.Equal(t, tt.wantOk, ok)
+			if !tt.wantOk {
+				return
+			}
+			require.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				require.Equal(t, tt.want[i].Option, got[i].Option)
+				require.Equal(t, sdk.MustNewDecFromStr(tt.want[i].Weight).String(), sdk.MustNewDecFromStr(got[i].Weight).String())
+			}
+		})
+	}
+}
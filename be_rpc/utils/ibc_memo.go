@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	IbcMemoHookTypeWasm = "wasm"
+	IbcMemoHookTypeEvm  = "evm"
+)
+
+// maxIbcMemoForwardHops limits the nesting level of forward instructions to be decoded,
+// to prevent abuse via deeply nested memo.
+const maxIbcMemoForwardHops = 32
+
+// IbcMemoForwardHop is a forward instruction of the packet-forward-middleware.
+type IbcMemoForwardHop struct {
+	Receiver string `json:"receiver"`
+	Port     string `json:"port"`
+	Channel  string `json:"channel"`
+}
+
+// IbcMemoHook is a contract execution instruction of the IBC wasm/evm hooks.
+type IbcMemoHook struct {
+	Type     string `json:"type"`
+	Contract string `json:"contract"`
+	Msg      any    `json:"msg,omitempty"`
+}
+
+// IbcMemo is the decoded form of the memo of ICS-20 transfers.
+type IbcMemo struct {
+	Forwards []IbcMemoForwardHop `json:"forwards,omitempty"`
+	Hook     *IbcMemoHook        `json:"hook,omitempty"`
+	// FinalReceiver is the receiver of the last hop, or the contract of the hook if any.
+	FinalReceiver string `json:"finalReceiver"`
+}
+
+// IsMultiHop returns true if the transfer will be forwarded to other chains.
+func (m IbcMemo) IsMultiHop() bool {
+	return len(m.Forwards) > 0
+}
+
+// Receivers returns all the addresses mentioned in the memo, include receivers of each hop and the hook contract.
+func (m IbcMemo) Receivers() []string {
+	receivers := make([]string, 0, len(m.Forwards)+1)
+	for _, forward := range m.Forwards {
+		if forward.Receiver != "" {
+			receivers = append(receivers, forward.Receiver)
+		}
+	}
+	if m.Hook != nil && m.Hook.Contract != "" {
+		receivers = append(receivers, m.Hook.Contract)
+	}
+	return receivers
+}
+
+// DecodeIbcMemo decodes JSON memo of packet-forward-middleware and IBC wasm/evm hooks.
+// Returns false if the memo is not a JSON object or does not contain any known instruction.
+func DecodeIbcMemo(memo string) (decoded IbcMemo, ok bool) {
+	obj, ok := unmarshalIbcMemoObject(memo)
+	if !ok {
+		return
+	}
+
+	for len(decoded.Forwards) < maxIbcMemoForwardHops {
+		if hook := getIbcMemoHook(obj); hook != nil {
+			decoded.Hook = hook
+			break
+		}
+
+		forward, isObj := obj["forward"].(map[string]any)
+		if !isObj {
+			break
+		}
+
+		receiver, _ := forward["receiver"].(string)
+		port, _ := forward["port"].(string)
+		channel, _ := forward["channel"].(string)
+		decoded.Forwards = append(decoded.Forwards, IbcMemoForwardHop{
+			Receiver: receiver,
+			Port:     port,
+			Channel:  channel,
+		})
+
+		// next can be either a JSON object or a JSON string, depends on version of the middleware
+		switch next := forward["next"].(type) {
+		case map[string]any:
+			obj = next
+		case string:
+			obj, _ = unmarshalIbcMemoObject(next)
+		default:
+			obj = nil
+		}
+
+		if obj == nil {
+			break
+		}
+	}
+
+	if decoded.Hook != nil {
+		decoded.FinalReceiver = decoded.Hook.Contract
+	} else if len(decoded.Forwards) > 0 {
+		decoded.FinalReceiver = decoded.Forwards[len(decoded.Forwards)-1].Receiver
+	} else {
+		ok = false
+	}
+
+	return
+}
+
+func unmarshalIbcMemoObject(memo string) (obj map[string]any, ok bool) {
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return nil, false
+	}
+
+	if err := json.Unmarshal([]byte(memo), &obj); err != nil {
+		return nil, false
+	}
+
+	return obj, true
+}
+
+func getIbcMemoHook(obj map[string]any) *IbcMemoHook {
+	for _, hookType := range []string{IbcMemoHookTypeWasm, IbcMemoHookTypeEvm} {
+		hook, isObj := obj[hookType].(map[string]any)
+		if !isObj {
+			continue
+		}
+
+		contract, _ := hook["contract"].(string)
+		if contract == "" {
+			continue
+		}
+
+		return &IbcMemoHook{
+			Type:     hookType,
+			Contract: contract,
+			Msg:      hook["msg"],
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDecodeIbcMemo(t *testing.T) {
+	testcases := []struct {
+		name   string
+		memo   string
+		wantOk bool
+		want   IbcMemo
+	}{
+		{
+			name:   "empty",
+			memo:   "",
+			wantOk: false,
+		},
+		{
+			name:   "plain text",
+			memo:   "hello",
+			wantOk: false,
+		},
+		{
+			name:   "unknown JSON",
+			memo:   `{"hello":"world"}`,
+			wantOk: false,
+		},
+		{
+			name:   "malformed JSON",
+			memo:   `{"forward":`,
+			wantOk: false,
+		},
+		{
+			name:   "single forward",
+			memo:   `{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-1","timeout":"10m","retries":2}}`,
+			wantOk: true,
+			want: IbcMemo{
+				Forwards: []IbcMemoForwardHop{
+					{Receiver: "cosmos1a", Port: "transfer", Channel: "channel-1"},
+				},
+				FinalReceiver: "cosmos1a",
+			},
+		},
+		{
+			name:   "nested forward as object",
+			memo:   `{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"osmo1b","port":"transfer","channel":"channel-2"}}}}`,
+			wantOk: true,
+			want: IbcMemo{
+				Forwards: []IbcMemoForwardHop{
+					{Receiver: "pfm", Port: "transfer", Channel: "channel-1"},
+					{Receiver: "osmo1b", Port: "transfer", Channel: "channel-2"},
+				},
+				FinalReceiver: "osmo1b",
+			},
+		},
+		{
+			name:   "nested forward as string",
+			memo:   `{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-1","next":"{\"forward\":{\"receiver\":\"osmo1b\",\"port\":\"transfer\",\"channel\":\"channel-2\"}}"}}`,
+			wantOk: true,
+			want: IbcMemo{
+				Forwards: []IbcMemoForwardHop{
+					{Receiver: "pfm", Port: "transfer", Channel: "channel-1"},
+					{Receiver: "osmo1b", Port: "transfer", Channel: "channel-2"},
+				},
+				FinalReceiver: "osmo1b",
+			},
+		},
+		{
+			name:   "wasm hook",
+			memo:   `{"wasm":{"contract":"osmo1contract","msg":{"swap":{}}}}`,
+			wantOk: true,
+			want: IbcMemo{
+				Hook: &IbcMemoHook{
+					Type:     IbcMemoHookTypeWasm,
+					Contract: "osmo1contract",
+					Msg:      map[string]any{"swap": map[string]any{}},
+				},
+				FinalReceiver: "osmo1contract",
+			},
+		},
+		{
+			name:   "evm hook",
+			memo:   `{"evm":{"contract":"0xContract"}}`,
+			wantOk: true,
+			want: IbcMemo{
+				Hook: &IbcMemoHook{
+					Type:     IbcMemoHookTypeEvm,
+					Contract: "0xContract",
+				},
+				FinalReceiver: "0xContract",
+			},
+		},
+		{
+			name:   "hook without contract is ignored",
+			memo:   `{"wasm":{"msg":{}}}`,
+			wantOk: false,
+		},
+		{
+			name:   "forward then wasm hook",
+			memo:   `{"forward":{"receiver":"osmo1contract","port":"transfer","channel":"channel-1","next":{"wasm":{"contract":"osmo1contract","msg":{}}}}}`,
+			wantOk: true,
+			want: IbcMemo{
+				Forwards: []IbcMemoForwardHop{
+					{Receiver: "osmo1contract", Port: "transfer", Channel: "channel-1"},
+				},
+				Hook: &IbcMemoHook{
+					Type:     IbcMemoHookTypeWasm,
+					Contract: "osmo1contract",
+					Msg:      map[string]any{},
+				},
+				FinalReceiver: "osmo1contract",
+			},
+		},
+	}
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DecodeIbcMemo(tt.memo)
+			require.Equal(t, tt.wantOk, ok)
+			if !tt.wantOk {
+				return
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIbcMemo_Receivers(t *testing.T) {
+	memo := IbcMemo{
+		Forwards: []IbcMemoForwardHop{
+			{Receiver: "a"},
+			{Receiver: ""},
+			{Receiver: "b"},
+		},
+		Hook: &IbcMemoHook{
+			Contract: "c",
+		},
+	}
+	require.Equal(t, []string{"a", "b", "c"}, memo.Receivers())
+	require.True(t, memo.IsMultiHop())
+	require.False(t, IbcMemo{}.IsMultiHop())
+}
@@ -1,15 +1,21 @@
 package utils
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	berpctypes "github.com/bcdevtools/block-explorer-rpc-cosmos/be_rpc/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"regexp"
 	"strings"
 )
 
+var patternHexString = regexp.MustCompile(`^(0[xX])?([\da-fA-F]{2})+$`)
+
 // NormalizeTransactionHash normalizes the transaction hash into '0xHASH' format.
 // Contract: input hash is a valid transaction hash, with or without '0x' prefix.
 func NormalizeTransactionHash(hash string, upper bool) string {
@@ -56,3 +62,26 @@ func GetEvmTransactionHashFromEvent(events []abci.Event) *common.Hash {
 	}
 	return nil
 }
+
+// DecodeTxBytesString decodes the raw transaction bytes, encoded in hex (with or without '0x' prefix) or base64.
+// Input is treated as hex if it contains only hex characters with even length, otherwise base64.
+func DecodeTxBytesString(encoded string) ([]byte, error) {
+	encoded = strings.TrimSpace(encoded)
+	if len(encoded) == 0 {
+		return nil, fmt.Errorf("empty tx bytes")
+	}
+
+	if patternHexString.MatchString(encoded) {
+		if strings.HasPrefix(encoded, "0x") || strings.HasPrefix(encoded, "0X") {
+			encoded = encoded[2:]
+		}
+		return hex.DecodeString(encoded)
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("tx bytes is neither hex nor base64 encoded")
+	}
+
+	return bz, nil
+}
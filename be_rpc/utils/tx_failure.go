@@ -0,0 +1,164 @@
+package utils
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"encoding/hex"
+	stderrors "errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/gogo/protobuf/proto"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var patternFailedMessageIndex = regexp.MustCompile(`message index: (\d+)`)
+
+// sdkErrorNames holds name of the errors registered in the root codespace of the Cosmos SDK,
+// because only the description is registered, the name is not available at runtime.
+var sdkErrorNames = map[uint32]string{
+	2:  "ErrTxDecode",
+	3:  "ErrInvalidSequence",
+	4:  "ErrUnauthorized",
+	5:  "ErrInsufficientFunds",
+	6:  "ErrUnknownRequest",
+	7:  "ErrInvalidAddress",
+	8:  "ErrInvalidPubKey",
+	9:  "ErrUnknownAddress",
+	10: "ErrInvalidCoins",
+	11: "ErrOutOfGas",
+	12: "ErrMemoTooLarge",
+	13: "ErrInsufficientFee",
+	14: "ErrTooManySignatures",
+	15: "ErrNoSignatures",
+	16: "ErrJSONMarshal",
+	17: "ErrJSONUnmarshal",
+	18: "ErrInvalidRequest",
+	19: "ErrTxInMempoolCache",
+	20: "ErrMempoolIsFull",
+	21: "ErrTxTooLarge",
+	22: "ErrKeyNotFound",
+	23: "ErrWrongPassword",
+	24: "ErrorInvalidSigner",
+	25: "ErrorInvalidGasAdjustment",
+	26: "ErrInvalidHeight",
+	27: "ErrInvalidVersion",
+	28: "ErrInvalidChainID",
+	29: "ErrInvalidType",
+	30: "ErrTxTimeoutHeight",
+	31: "ErrUnknownExtensionOptions",
+	32: "ErrWrongSequence",
+	33: "ErrPackAny",
+	34: "ErrUnpackAny",
+	35: "ErrLogic",
+	36: "ErrConflict",
+	37: "ErrNotSupported",
+	38: "ErrNotFound",
+	39: "ErrIO",
+	40: "ErrAppConfig",
+	41: "ErrInvalidGasLimit",
+}
+
+// GetSdkErrorName returns the name of the error registered in the root codespace of the Cosmos SDK.
+func GetSdkErrorName(codespace string, code uint32) (string, bool) {
+	if codespace != sdkerrors.RootCodespace {
+		return "", false
+	}
+
+	name, found := sdkErrorNames[code]
+	return name, found
+}
+
+// GetRegisteredErrorDescription returns the description of the error registered for the codespace and code.
+// Errors of all modules of the running app are registered, so this works for modules without a parser too.
+func GetRegisteredErrorDescription(codespace string, code uint32) (string, bool) {
+	var registeredErr *errorsmod.Error
+	if !stderrors.As(errorsmod.ABCIError(codespace, code, ""), &registeredErr) {
+		return "", false
+	}
+
+	description := registeredErr.Error()
+	if description == "" || description == "unknown" {
+		return "", false
+	}
+
+	return description, true
+}
+
+// ParseFailedMessageIndex parses the index of the failed message from the raw log of the failed tx.
+func ParseFailedMessageIndex(rawLog string) (int, bool) {
+	matches := patternFailedMessageIndex.FindStringSubmatch(rawLog)
+	if len(matches) != 2 {
+		return 0, false
+	}
+
+	idx, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return idx, true
+}
+
+// evmTxResponse mirrors the fields of the MsgEthereumTxResponse of the EVM module,
+// which are needed to decode the VM error and the revert reason.
+type evmTxResponse struct {
+	Ret     []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *evmTxResponse) Reset()         { *m = evmTxResponse{} }
+func (m *evmTxResponse) String() string { return proto.CompactTextString(m) }
+func (*evmTxResponse) ProtoMessage()    {}
+
+// GetEvmTxResultFromTxData decodes the hex tx result data, finds the MsgEthereumTxResponse
+// and returns the VM error and the returned data of the EVM execution.
+func GetEvmTxResultFromTxData(txDataHex string) (vmError string, ret []byte, found bool) {
+	bz, err := hex.DecodeString(txDataHex)
+	if err != nil || len(bz) == 0 {
+		return
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(bz, &txMsgData); err != nil {
+		return
+	}
+
+	var evmTxResponseBz []byte
+	for _, msgResponse := range txMsgData.MsgResponses {
+		if msgResponse != nil && strings.HasSuffix(msgResponse.TypeUrl, ".MsgEthereumTxResponse") {
+			evmTxResponseBz = msgResponse.Value
+			break
+		}
+	}
+	if evmTxResponseBz == nil {
+		for _, msgData := range txMsgData.Data {
+			if msgData != nil && strings.HasSuffix(msgData.MsgType, ".MsgEthereumTx") {
+				evmTxResponseBz = msgData.Data
+				break
+			}
+		}
+	}
+	if evmTxResponseBz == nil {
+		return
+	}
+
+	var res evmTxResponse
+	if err := proto.Unmarshal(evmTxResponseBz, &res); err != nil {
+		return
+	}
+
+	return res.VmError, res.Ret, true
+}
+
+// DecodeEvmRevertReason decodes the revert reason from the returned data of the reverted EVM execution,
+// which is ABI encoded as Error(string).
+func DecodeEvmRevertReason(ret []byte) (string, bool) {
+	reason, err := abi.UnpackRevert(ret)
+	if err != nil {
+		return "", false
+	}
+
+	return reason, true
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"encoding/hex"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestGetSdkErrorName(t *testing.T) {
+	name, found := GetSdkErrorName(sdkerrors.RootCodespace, sdkerrors.ErrInsufficientFunds.ABCICode())
+	require.True(t, found)
+	require.Equal(t, "ErrInsufficientFunds", name)
+
+	name, found = GetSdkErrorName(sdkerrors.RootCodespace, sdkerrors.ErrOutOfGas.ABCICode())
+	require.True(t, found)
+	require.Equal(t, "ErrOutOfGas", name)
+
+	_, found = GetSdkErrorName("bank", sdkerrors.ErrOutOfGas.ABCICode())
+	require.False(t, found)
+
+	_, found = GetSdkErrorName(sdkerrors.RootCodespace, 999999)
+	require.False(t, found)
+}
+
+func TestGetRegisteredErrorDescription(t *testing.T) {
+	description, found := GetRegisteredErrorDescription(sdkerrors.RootCodespace, sdkerrors.ErrInsufficientFunds.ABCICode())
+	require.True(t, found)
+	require.Equal(t, "insufficient funds", description)
+
+	_, found = GetRegisteredErrorDescription("not-registered", 1)
+	require.False(t, found)
+}
+
+func TestParseFailedMessageIndex(t *testing.T) {
+	tests := []struct {
+		rawLog    string
+		wantIdx   int
+		wantFound bool
+	}{
+		{
+			rawLog:    "failed to execute message; message index: 0: 1stake is smaller than 2stake: insufficient funds",
+			wantIdx:   0,
+			wantFound: true,
+		},
+		{
+			rawLog:    "failed to execute message; message index: 12: unauthorized",
+			wantIdx:   12,
+			wantFound: true,
+		},
+		{
+			rawLog:    "out of gas in location: WriteFlat; gasWanted: 200000, gasUsed: 200100: out of gas",
+			wantFound: false,
+		},
+		{
+			rawLog:    "",
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rawLog, func(t *testing.T) {
+			idx, found := ParseFailedMessageIndex(tt.rawLog)
+			require.Equal(t, tt.wantFound, found)
+			require.Equal(t, tt.wantIdx, idx)
+		})
+	}
+}
+
+// testEvmTxResponse is a subset of the MsgEthereumTxResponse, with the hash field to ensure unknown fields are skipped.
+type testEvmTxResponse struct {
+	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3"`
+	Ret     []byte `protobuf:"bytes,3,opt,name=ret,proto3"`
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3"`
+}
+
+func (m *testEvmTxResponse) Reset()         { *m = testEvmTxResponse{} }
+func (m *testEvmTxResponse) String() string { return proto.CompactTextString(m) }
+func (*testEvmTxResponse) ProtoMessage()    {}
+
+func TestGetEvmTxResultFromTxData(t *testing.T) {
+	// Error(string) with reason "not enough balance"
+	ret, err := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000012" +
+		"6e6f7420656e6f7567682062616c616e63650000000000000000000000000000")
+	require.NoError(t, err)
+
+	evmTxResponseBz, err := proto.Marshal(&testEvmTxResponse{
+		Hash:    "0xhash",
+		Ret:     ret,
+		VmError: "execution reverted",
+	})
+	require.NoError(t, err)
+
+	txMsgDataBz, err := proto.Marshal(&sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{
+			{
+				TypeUrl: "/ethermint.evm.v1.MsgEthereumTxResponse",
+				Value:   evmTxResponseBz,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	vmError, gotRet, found := GetEvmTxResultFromTxData(strings.ToUpper(hex.EncodeToString(txMsgDataBz)))
+	require.True(t, found)
+	require.Equal(t, "execution reverted", vmError)
+	require.Equal(t, ret, gotRet)
+
+	reason, ok := DecodeEvmRevertReason(gotRet)
+	require.True(t, ok)
+	require.Equal(t, "not enough balance", reason)
+
+	_, _, found = GetEvmTxResultFromTxData("")
+	require.False(t, found)
+
+	_, _, found = GetEvmTxResultFromTxData("not-hex")
+	require.False(t, found)
+
+	_, ok = DecodeEvmRevertReason(nil)
+	require.False(t, ok)
+}
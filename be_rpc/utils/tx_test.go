@@ -48,3 +48,64 @@ func TestNormalizeTransactionHash(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeTxBytesString(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			encoded: "",
+			wantErr: true,
+		},
+		{
+			name:    "hex",
+			encoded: "0a0b",
+			want:    []byte{0x0a, 0x0b},
+		},
+		{
+			name:    "hex with 0x prefix",
+			encoded: "0x0A0B",
+			want:    []byte{0x0a, 0x0b},
+		},
+		{
+			name:    "hex with 0X prefix",
+			encoded: "0X0a0b",
+			want:    []byte{0x0a, 0x0b},
+		},
+		{
+			name:    "base64",
+			encoded: "CgsM",
+			want:    []byte{0x0a, 0x0b, 0x0c},
+		},
+		{
+			name:    "base64 with padding",
+			encoded: "Cgs=",
+			want:    []byte{0x0a, 0x0b},
+		},
+		{
+			name:    "surrounding spaces are trimmed",
+			encoded: " 0a0b ",
+			want:    []byte{0x0a, 0x0b},
+		},
+		{
+			name:    "invalid",
+			encoded: "not-encoded!",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeTxBytesString(tt.encoded)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
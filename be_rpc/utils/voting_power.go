@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"math"
+	"sort"
+)
+
+// SortVotingPowersDesc returns a copy of the voting powers, sorted descending.
+func SortVotingPowersDesc(votingPowers []int64) []int64 {
+	sorted := make([]int64, len(votingPowers))
+	copy(sorted, votingPowers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] > sorted[j]
+	})
+	return sorted
+}
+
+// NakamotoCoefficient returns the minimum number of validators, which their combined voting power
+// reaches the given threshold (0.0 -> 1.0) of the total voting power.
+// Returns 0 if there is no voting power.
+func NakamotoCoefficient(votingPowers []int64, threshold float64) int {
+	sorted := SortVotingPowersDesc(votingPowers)
+
+	var total int64
+	for _, votingPower := range sorted {
+		total += votingPower
+	}
+	if total < 1 {
+		return 0
+	}
+
+	var cumulative int64
+	for i, votingPower := range sorted {
+		cumulative += votingPower
+		if float64(cumulative)/float64(total) >= threshold {
+			return i + 1
+		}
+	}
+
+	return len(sorted)
+}
+
+// GiniCoefficient returns the Gini coefficient (0.0 -> 1.0) of the voting powers,
+// 0 means perfect equality and closer to 1 means voting power is concentrated into few validators.
+func GiniCoefficient(votingPowers []int64) float64 {
+	n := len(votingPowers)
+	if n < 1 {
+		return 0
+	}
+
+	sorted := SortVotingPowersDesc(votingPowers)
+
+	// G = (2 * sum(i * x_i)) / (n * sum(x_i)) - (n + 1) / n, with x sorted ascending and i is 1-based
+	var total, weightedSum float64
+	for i := 0; i < n; i++ {
+		votingPower := float64(sorted[n-1-i])
+		total += votingPower
+		weightedSum += float64(i+1) * votingPower
+	}
+	if total <= 0 {
+		return 0
+	}
+
+	gini := (2*weightedSum)/(float64(n)*total) - float64(n+1)/float64(n)
+	return math.Max(0, gini)
+}
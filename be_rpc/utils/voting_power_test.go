@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSortVotingPowersDesc(t *testing.T) {
+	votingPowers := []int64{1, 3, 2}
+	require.Equal(t, []int64{3, 2, 1}, SortVotingPowersDesc(votingPowers))
+	require.Equal(t, []int64{1, 3, 2}, votingPowers, "input must not be modified")
+}
+
+func TestNakamotoCoefficient(t *testing.T) {
+	testcases := []struct {
+		name         string
+		votingPowers []int64
+		threshold    float64
+		want         int
+	}{
+		{
+			name:         "empty",
+			votingPowers: nil,
+			threshold:    0.334,
+			want:         0,
+		},
+		{
+			name:         "zero voting power",
+			votingPowers: []int64{0, 0},
+			threshold:    0.334,
+			want:         0,
+		},
+		{
+			name:         "single validator",
+			votingPowers: []int64{100},
+			threshold:    0.667,
+			want:         1,
+		},
+		{
+			name:         "equal voting power, 1/3",
+			votingPowers: []int64{10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
+			threshold:    0.334,
+			want:         4,
+		},
+		{
+			name:         "equal voting power, 2/3",
+			votingPowers: []int64{10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
+			threshold:    0.667,
+			want:         7,
+		},
+		{
+			name:         "concentrated, unsorted input",
+			votingPowers: []int64{5, 50, 5, 30, 10},
+			threshold:    0.334,
+			want:         1,
+		},
+		{
+			name:         "concentrated, 2/3",
+			votingPowers: []int64{5, 50, 5, 30, 10},
+			threshold:    0.667,
+			want:         2,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, NakamotoCoefficient(tc.votingPowers, tc.threshold))
+		})
+	}
+}
+
+func TestGiniCoefficient(t *testing.T) {
+	testcases := []struct {
+		name         string
+		votingPowers []int64
+		want         float64
+	}{
+		{
+			name:         "empty",
+			votingPowers: nil,
+			want:         0,
+		},
+		{
+			name:         "zero voting power",
+			votingPowers: []int64{0, 0},
+			want:         0,
+		},
+		{
+			name:         "perfect equality",
+			votingPowers: []int64{10, 10, 10, 10},
+			want:         0,
+		},
+		{
+			name:         "all voting power in one of four",
+			votingPowers: []int64{0, 0, 100, 0},
+			want:         0.75,
+		},
+		{
+			name:         "linear distribution",
+			votingPowers: []int64{1, 2, 3, 4},
+			want:         0.25,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.InDelta(t, tc.want, GiniCoefficient(tc.votingPowers), 1e-9)
+		})
+	}
+}